@@ -0,0 +1,176 @@
+package lists
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+)
+
+// CircularList is a ring of doubly linked nodes with a cursor to its
+// current node, suited for round-robin traversals where the last node
+// is followed by the first one.
+//
+// The zero value is an empty CircularList ready to use.
+type CircularList[T comparable] struct {
+	len     int
+	current *circularNode[T]
+}
+
+type circularNode[T comparable] struct {
+	value T
+	prev  *circularNode[T]
+	next  *circularNode[T]
+}
+
+// Current attempts to return the current value and reports
+// whether it succeeded.
+//
+// Time O(1) and space O(1).
+func (l *CircularList[T]) Current() (T, bool) {
+	if l.current == nil {
+		var v T
+		return v, false
+	}
+
+	return l.current.value, true
+}
+
+// Advance moves the current position one step forward.
+//
+// Time O(1) and space O(1).
+func (l *CircularList[T]) Advance() {
+	if l.current != nil {
+		l.current = l.current.next
+	}
+}
+
+// Rotate moves the current position k steps forward,
+// or backward if k is negative.
+//
+// Time O(min(k, n-k)) and space O(1), where k is taken modulo n.
+func (l *CircularList[T]) Rotate(k int) {
+	if l.len == 0 {
+		return
+	}
+
+	k %= l.len
+	if k < 0 {
+		k += l.len
+	}
+
+	if k <= l.len/2 {
+		for range k {
+			l.current = l.current.next
+		}
+		return
+	}
+
+	for range l.len - k {
+		l.current = l.current.prev
+	}
+}
+
+// InsertAfterCurrent inserts value right after the current position,
+// which is kept. If CircularList is empty, value becomes current.
+//
+// Time O(1) and space O(1).
+func (l *CircularList[T]) InsertAfterCurrent(value T) {
+	newNode := &circularNode[T]{value: value}
+	l.len++
+
+	if l.current == nil {
+		newNode.prev, newNode.next = newNode, newNode
+		l.current = newNode
+		return
+	}
+
+	newNode.prev = l.current
+	newNode.next = l.current.next
+	l.current.next.prev = newNode
+	l.current.next = newNode
+}
+
+// RemoveCurrent attempts to remove and return the current value and
+// reports whether it succeeded. The following value becomes current.
+//
+// Time O(1) and space O(1).
+func (l *CircularList[T]) RemoveCurrent() (T, bool) {
+	if l.current == nil {
+		var v T
+		return v, false
+	}
+
+	removed := l.current
+	l.len--
+
+	if l.len == 0 {
+		l.current = nil
+	} else {
+		removed.prev.next = removed.next
+		removed.next.prev = removed.prev
+		l.current = removed.next
+	}
+
+	removed.prev, removed.next = nil, nil
+
+	return removed.value, true
+}
+
+// Len returns CircularList's length.
+func (l *CircularList[T]) Len() int {
+	return l.len
+}
+
+// Cycle returns an iterator that yields n values going around
+// CircularList from the current position, wrapping as needed,
+// without moving it. It yields nothing if CircularList is empty.
+func (l *CircularList[T]) Cycle(n int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if l.current == nil {
+			return
+		}
+
+		currentNode := l.current
+		for range n {
+			if !yield(currentNode.value) {
+				return
+			}
+			currentNode = currentNode.next
+		}
+	}
+}
+
+// Values returns an iterator over CircularList's elements, going
+// around once from the current position when iteration starts.
+func (l *CircularList[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		// read the length lazily, so changes made after
+		// Values is called and before ranging are seen
+		l.Cycle(l.len)(yield)
+	}
+}
+
+// String formats CircularList's values starting at the current position.
+func (l *CircularList[T]) String() string {
+	var builder strings.Builder
+
+	// assume each element requires at least one byte for printing
+	// and one byte for spacing between elements
+	// len("CircularList[]") + (CircularList.len * 2)
+	builder.Grow(14 + (l.len * 2))
+
+	builder.WriteString("CircularList[")
+
+	i := 0
+	for v := range l.Values() {
+		if i != 0 {
+			builder.WriteString(" ")
+		}
+		builder.WriteString(fmt.Sprint(v))
+		i++
+	}
+
+	builder.WriteString("]")
+
+	return builder.String()
+}
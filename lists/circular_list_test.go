@@ -0,0 +1,135 @@
+package lists
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+// circularFrom returns a CircularList holding values in order,
+// with the first one as current.
+func circularFrom[T comparable](values ...T) *CircularList[T] {
+	l := &CircularList[T]{}
+	for _, v := range values {
+		l.InsertAfterCurrent(v)
+		l.Advance()
+	}
+	l.Advance()
+
+	return l
+}
+
+func TestCircularListEmpty(t *testing.T) {
+	l := &CircularList[int]{}
+
+	l.Advance()
+	l.Rotate(3)
+
+	if v, ok := l.Current(); v != 0 || ok {
+		t.Errorf("Current() = (%v, %v), want (0, false)", v, ok)
+	}
+	if v, ok := l.RemoveCurrent(); v != 0 || ok {
+		t.Errorf("RemoveCurrent() = (%v, %v), want (0, false)", v, ok)
+	}
+	if got := slices.Collect(l.Cycle(5)); got != nil {
+		t.Errorf("Cycle(5) = %v, want []", got)
+	}
+	if got, want := l.String(), "CircularList[]"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
+
+func TestCircularListRotate(t *testing.T) {
+	tests := []struct {
+		k    int
+		want string
+	}{
+		{0, "CircularList[a b c d e]"},
+		{1, "CircularList[b c d e a]"},
+		{4, "CircularList[e a b c d]"},
+		{5, "CircularList[a b c d e]"},
+		{-1, "CircularList[e a b c d]"},
+		{-7, "CircularList[d e a b c]"},
+		{12, "CircularList[c d e a b]"},
+	}
+
+	for i, test := range tests {
+		l := circularFrom("a", "b", "c", "d", "e")
+		l.Rotate(test.k)
+
+		if got := l.String(); got != test.want {
+			t.Errorf("%d: Rotate(%d) = %s, want %s", i, test.k, got, test.want)
+		}
+	}
+}
+
+func TestCircularListEdit(t *testing.T) {
+	l := circularFrom("a", "c")
+
+	l.InsertAfterCurrent("b")
+	if got, want := l.String(), "CircularList[a b c]"; got != want || l.Len() != 3 {
+		t.Errorf("InsertAfterCurrent(\"b\") = %s, want %s", got, want)
+	}
+
+	l.Rotate(2)
+	if v, ok := l.RemoveCurrent(); v != "c" || !ok {
+		t.Errorf("RemoveCurrent() = (%q, %v), want (\"c\", true)", v, ok)
+	}
+	if v, ok := l.Current(); v != "a" || !ok {
+		t.Errorf("Current() after removal = (%q, %v), want (\"a\", true)", v, ok)
+	}
+
+	if got, want := slices.Collect(l.Cycle(5)), []string{"a", "b", "a", "b", "a"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Cycle(5) = %v, want %v", got, want)
+	}
+
+	l.RemoveCurrent()
+	l.RemoveCurrent()
+	if l.Len() != 0 || l.current != nil {
+		t.Errorf("%v is not empty", l)
+	}
+}
+
+func TestCircularListValuesAfterEdit(t *testing.T) {
+	l := circularFrom(1, 2)
+
+	values := l.Values()
+	l.InsertAfterCurrent(3)
+	if got, want := slices.Collect(values), []int{1, 3, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Values() created before InsertAfterCurrent = %v, want %v", got, want)
+	}
+
+	values = l.Values()
+	l.RemoveCurrent()
+	if got, want := slices.Collect(values), []int{3, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Values() created before RemoveCurrent = %v, want %v", got, want)
+	}
+}
+
+// TestCircularListJosephus eliminates every k-th person of a circle
+// until one is left.
+func TestCircularListJosephus(t *testing.T) {
+	const (
+		n = 7
+		k = 3
+	)
+
+	l := &CircularList[int]{}
+	for i := range n {
+		l.InsertAfterCurrent(i + 1)
+		l.Advance()
+	}
+	l.Advance()
+
+	var eliminated []int
+	for l.Len() > 1 {
+		l.Rotate(k - 1)
+		v, _ := l.RemoveCurrent()
+		eliminated = append(eliminated, v)
+	}
+
+	survivor, _ := l.Current()
+	if want := []int{3, 6, 2, 7, 5, 1}; !slices.Equal(eliminated, want) || survivor != 4 {
+		t.Errorf("Josephus(%d, %d) = %v and %d, want %v and 4", n, k, eliminated, survivor, want)
+	}
+}
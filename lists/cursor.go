@@ -0,0 +1,128 @@
+package lists
+
+// Cursor points to an element of a [LinkedList] and allows editing
+// the list around it in O(1), so a list can be modified in a single
+// pass without index-based operations.
+//
+// A Cursor is invalid when it points past the end of the list, see
+// [Cursor.Valid]. A Cursor becomes stale when its list's nodes are
+// moved to another list by [LinkedList.Concat], [LinkedList.Splice]
+// or [LinkedList.SplitAt], or when its element is removed, by another
+// Cursor or by LinkedList's methods, and using it then panics.
+type Cursor[T comparable] struct {
+	list *LinkedList[T]
+	node *node[T]
+	// version is the list's version when Cursor was taken.
+	version int
+}
+
+// Front returns a Cursor to the first element of LinkedList,
+// which is invalid if LinkedList is empty.
+//
+// Time O(1) and space O(1).
+func (l *LinkedList[T]) Front() Cursor[T] {
+	return Cursor[T]{l, l.head, l.version}
+}
+
+// Find returns a Cursor to the first element that contains value,
+// which is invalid if value is not found.
+//
+// Time O(n) and space O(1).
+func (l *LinkedList[T]) Find(value T) Cursor[T] {
+	for _, n := range l.all() {
+		if n.value == value {
+			return Cursor[T]{l, n, l.version}
+		}
+	}
+
+	return Cursor[T]{l, nil, l.version}
+}
+
+// Valid reports whether Cursor points to an element.
+func (c Cursor[T]) Valid() bool {
+	return c.node != nil
+}
+
+// Next returns a Cursor to the following element, which is invalid
+// if Cursor points to the last element.
+// It panics if Cursor is invalid or stale.
+//
+// Time O(1) and space O(1).
+func (c Cursor[T]) Next() Cursor[T] {
+	c.mustBeValid()
+
+	return Cursor[T]{c.list, c.node.next, c.version}
+}
+
+// Value returns the value of Cursor's element.
+// It panics if Cursor is invalid or stale.
+//
+// Time O(1) and space O(1).
+func (c Cursor[T]) Value() T {
+	c.mustBeValid()
+
+	return c.node.value
+}
+
+// Set replaces the value of Cursor's element.
+// It panics if Cursor is invalid or stale.
+//
+// Time O(1) and space O(1).
+func (c Cursor[T]) Set(value T) {
+	c.mustBeValid()
+
+	c.node.value = value
+}
+
+// InsertAfter inserts value right after Cursor's element and returns
+// a Cursor to it.
+// It panics if Cursor is invalid or stale.
+//
+// Time O(1) and space O(1).
+func (c Cursor[T]) InsertAfter(value T) Cursor[T] {
+	c.mustBeValid()
+
+	newNode := &node[T]{
+		value: value,
+		next:  c.node.next,
+	}
+	c.node.next = newNode
+
+	if c.list.tail == c.node {
+		c.list.tail = newNode
+	}
+	c.list.len++
+
+	return Cursor[T]{c.list, newNode, c.version}
+}
+
+// RemoveNext attempts to remove and return the value of the element
+// following Cursor's element and reports whether it succeeded.
+// It panics if Cursor is invalid or stale.
+//
+// Time O(1) and space O(1).
+func (c Cursor[T]) RemoveNext() (T, bool) {
+	c.mustBeValid()
+
+	removed := c.node.next
+	if removed == nil {
+		var v T
+		return v, false
+	}
+
+	c.list.unlinkAfter(c.node)
+
+	return removed.value, true
+}
+
+func (c Cursor[T]) mustBeValid() {
+	if c.node == nil {
+		panic("invalid cursor")
+	}
+	if c.version != c.list.version {
+		panic("stale cursor: its list's nodes were moved")
+	}
+	if c.node.removed {
+		panic("stale cursor: its element was removed")
+	}
+}
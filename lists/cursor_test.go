@@ -0,0 +1,179 @@
+package lists
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCursor(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		l := &LinkedList[int]{}
+
+		if c := l.Front(); c.Valid() {
+			t.Errorf("%v.Front() is valid", l)
+		}
+		if c := l.Find(0); c.Valid() {
+			t.Errorf("%v.Find(0) is valid", l)
+		}
+
+		var c Cursor[int]
+		if !panics(func() { c.Value() }) {
+			t.Errorf("invalid Cursor.Value() expected to panic")
+		}
+		if !panics(func() { c.Next() }) {
+			t.Errorf("invalid Cursor.Next() expected to panic")
+		}
+		if !panics(func() { c.InsertAfter(1) }) {
+			t.Errorf("invalid Cursor.InsertAfter(1) expected to panic")
+		}
+	})
+
+	t.Run("traversal", func(t *testing.T) {
+		l := &LinkedList[int]{}
+		for i := range 5 {
+			l.Append(i)
+		}
+
+		var got []int
+		for c := l.Front(); c.Valid(); c = c.Next() {
+			got = append(got, c.Value())
+		}
+
+		if want := []int{0, 1, 2, 3, 4}; !slices.Equal(got, want) {
+			t.Errorf("traversal = %v, want %v", got, want)
+		}
+
+		if c := l.Find(3); !c.Valid() || c.Value() != 3 {
+			t.Errorf("%v.Find(3) did not find 3", l)
+		}
+	})
+
+	t.Run("single pass edit", func(t *testing.T) {
+		l := &LinkedList[int]{}
+		for i := range 6 {
+			l.Append(i)
+		}
+
+		// remove odd values and duplicate even ones multiplied by 10
+		c := l.Front()
+		for c.Valid() {
+			c.RemoveNext()
+			c.Set(c.Value() * 10)
+			c = c.InsertAfter(c.Value() + 1).Next()
+		}
+
+		if got, want := l.String(), "LinkedList[0 1 20 21 40 41]"; got != want || l.Len() != 6 {
+			t.Errorf("edited list = %s (len %d), want %s", got, l.Len(), want)
+		}
+
+		// tail must follow InsertAfter at the end
+		l.Append(50)
+		if got, want := l.String(), "LinkedList[0 1 20 21 40 41 50]"; got != want {
+			t.Errorf("Append after edit = %s, want %s", got, want)
+		}
+	})
+
+	t.Run("RemoveNext tail", func(t *testing.T) {
+		l := &LinkedList[string]{}
+		l.Append("a")
+		l.Append("b")
+
+		c := l.Front()
+		if v, ok := c.RemoveNext(); v != "b" || !ok {
+			t.Errorf("RemoveNext() = (%q, %v), want (\"b\", true)", v, ok)
+		}
+		if v, ok := c.RemoveNext(); v != "" || ok {
+			t.Errorf("RemoveNext() = (%q, %v), want (\"\", false)", v, ok)
+		}
+
+		l.Append("c")
+		if got, want := l.String(), "LinkedList[a c]"; got != want || l.Len() != 2 {
+			t.Errorf("Append after RemoveNext = %s, want %s", got, want)
+		}
+	})
+
+	t.Run("stale", func(t *testing.T) {
+		moves := []struct {
+			name string
+			fn   func(a, b *LinkedList[int])
+		}{
+			{"Concat", func(a, b *LinkedList[int]) { a.Concat(b) }},
+			{"Splice", func(a, b *LinkedList[int]) { a.Splice(0, b) }},
+			{"SplitAt", func(a, b *LinkedList[int]) { b.SplitAt(1) }},
+		}
+
+		for _, move := range moves {
+			a, b := linkedFrom(1), linkedFrom(2)
+			c := b.Front()
+
+			move.fn(a, b)
+
+			if !panics(func() { c.InsertAfter(3) }) {
+				t.Errorf("%s: InsertAfter() on a stale cursor expected to panic", move.name)
+			}
+			if !panics(func() { c.RemoveNext() }) {
+				t.Errorf("%s: RemoveNext() on a stale cursor expected to panic", move.name)
+			}
+			if err := a.Validate(); err != nil {
+				t.Errorf("%s: %v", move.name, err)
+			}
+
+			// cursors taken after the move work again
+			b.Append(4)
+			b.Front().InsertAfter(5)
+			if got, want := b.String(), "LinkedList[4 5]"; got != want {
+				t.Errorf("%s: edit after the move = %s, want %s", move.name, got, want)
+			}
+		}
+
+		// cursors to the receiving list stay usable
+		a, b := linkedFrom(1), linkedFrom(2)
+		c := a.Front()
+		a.Concat(b)
+		c.InsertAfter(3)
+		if got, want := a.String(), "LinkedList[1 3 2]"; got != want {
+			t.Errorf("InsertAfter() after Concat = %s, want %s", got, want)
+		}
+	})
+	t.Run("removed", func(t *testing.T) {
+		removals := []struct {
+			name string
+			fn   func(l *LinkedList[int])
+		}{
+			{"Delete", func(l *LinkedList[int]) { l.Delete(1) }},
+			{"PopFront", func(l *LinkedList[int]) { l.PopFront(); l.PopFront() }},
+			{"DeleteValue", func(l *LinkedList[int]) { l.DeleteValue(2) }},
+			{"RemoveIf", func(l *LinkedList[int]) { l.RemoveIf(func(v int) bool { return v == 2 }) }},
+			{"RemoveNext", func(l *LinkedList[int]) { l.Front().RemoveNext() }},
+		}
+
+		for _, removal := range removals {
+			l := linkedFrom(1, 2, 3)
+			c := l.Find(2)
+
+			removal.fn(l)
+
+			if !panics(func() { c.InsertAfter(9) }) {
+				t.Errorf("%s: InsertAfter() on a removed element expected to panic", removal.name)
+			}
+			if !panics(func() { c.RemoveNext() }) {
+				t.Errorf("%s: RemoveNext() on a removed element expected to panic", removal.name)
+			}
+			if err := l.Validate(); err != nil {
+				t.Errorf("%s: %v", removal.name, err)
+			}
+		}
+
+		// removing another element leaves the cursor usable
+		l := linkedFrom(1, 2, 3)
+		c := l.Find(3)
+		l.Delete(1)
+		c.InsertAfter(9)
+		if got, want := l.String(), "LinkedList[1 3 9]"; got != want || l.Len() != 3 {
+			t.Errorf("InsertAfter() after Delete = %s, want %s", got, want)
+		}
+		if err := l.Validate(); err != nil {
+			t.Error(err)
+		}
+	})
+}
@@ -0,0 +1,267 @@
+package lists
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+)
+
+var _ List[int] = &DoublyLinkedList[int]{}
+
+// DoublyLinkedList is a [List] implementation where each node links to
+// both its previous and next nodes, and the list keeps pointers to its
+// head and tail, so operations at both ends are O(1).
+//
+// The zero value is an empty DoublyLinkedList ready to use.
+type DoublyLinkedList[T comparable] struct {
+	len  int
+	head *doublyNode[T]
+	tail *doublyNode[T]
+}
+
+type doublyNode[T comparable] struct {
+	value T
+	prev  *doublyNode[T]
+	next  *doublyNode[T]
+}
+
+// PushFront adds value to the front of DoublyLinkedList.
+//
+// Time O(1) and space O(1).
+func (l *DoublyLinkedList[T]) PushFront(value T) {
+	newNode := &doublyNode[T]{
+		value: value,
+		next:  l.head,
+	}
+
+	if l.head == nil {
+		l.tail = newNode
+	} else {
+		l.head.prev = newNode
+	}
+
+	l.head = newNode
+	l.len++
+}
+
+// PushBack adds value to the back of DoublyLinkedList.
+//
+// Time O(1) and space O(1).
+func (l *DoublyLinkedList[T]) PushBack(value T) {
+	newNode := &doublyNode[T]{
+		value: value,
+		prev:  l.tail,
+	}
+
+	if l.tail == nil {
+		l.head = newNode
+	} else {
+		l.tail.next = newNode
+	}
+
+	l.tail = newNode
+	l.len++
+}
+
+// PopFront attempts to remove and return the value at the front
+// of DoublyLinkedList and reports whether it succeeded.
+//
+// Time O(1) and space O(1).
+func (l *DoublyLinkedList[T]) PopFront() (T, bool) {
+	if l.head == nil {
+		var v T
+		return v, false
+	}
+
+	value := l.head.value
+	l.unlink(l.head)
+
+	return value, true
+}
+
+// PopBack attempts to remove and return the value at the back
+// of DoublyLinkedList and reports whether it succeeded.
+//
+// Time O(1) and space O(1).
+func (l *DoublyLinkedList[T]) PopBack() (T, bool) {
+	if l.tail == nil {
+		var v T
+		return v, false
+	}
+
+	value := l.tail.value
+	l.unlink(l.tail)
+
+	return value, true
+}
+
+// Read returns the value at the provided index.
+// It panics if index is out of bounds.
+//
+// Time O(n) and space O(1), walking from the closest end.
+func (l *DoublyLinkedList[T]) Read(index int) T {
+	if index < 0 || index >= l.len {
+		panic(fmt.Sprintf("index out of range [%d] with length %d", index, l.len))
+	}
+
+	return l.nodeAt(index).value
+}
+
+// Search returns the first index that contains value or -1.
+//
+// Time O(n) and space O(1).
+func (l *DoublyLinkedList[T]) Search(value T) int {
+	for i, v := range l.All() {
+		if v == value {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// Insert inserts value at the provided index.
+// It panics if index is out of range.
+//
+// Time O(n) and space O(1), walking from the closest end.
+// Inserting at either end is O(1).
+func (l *DoublyLinkedList[T]) Insert(value T, index int) {
+	if index < 0 || index > l.len {
+		panic(fmt.Sprintf("index out of range [%d] with length %d", index, l.len))
+	}
+
+	if index == 0 {
+		l.PushFront(value)
+		return
+	}
+	if index == l.len {
+		l.PushBack(value)
+		return
+	}
+
+	nextNode := l.nodeAt(index)
+	newNode := &doublyNode[T]{
+		value: value,
+		prev:  nextNode.prev,
+		next:  nextNode,
+	}
+
+	nextNode.prev.next = newNode
+	nextNode.prev = newNode
+	l.len++
+}
+
+// Delete removes value at provided index.
+// It panics if index is out of bounds.
+//
+// Time O(n) and space O(1), walking from the closest end.
+// Deleting at either end is O(1).
+func (l *DoublyLinkedList[T]) Delete(index int) {
+	if index < 0 || index >= l.len {
+		panic(fmt.Sprintf("index out of range [%d] with length %d", index, l.len))
+	}
+
+	l.unlink(l.nodeAt(index))
+}
+
+// Len returns DoublyLinkedList's length.
+func (l *DoublyLinkedList[T]) Len() int {
+	return l.len
+}
+
+func (l *DoublyLinkedList[T]) String() string {
+	var builder strings.Builder
+
+	// assume each element requires at least one byte for printing
+	// and one byte for spacing between elements
+	// len("DoublyLinkedList[]") + (DoublyLinkedList.len * 2)
+	builder.Grow(18 + (l.len * 2))
+
+	builder.WriteString("DoublyLinkedList[")
+
+	for currentNode := l.head; currentNode != nil; currentNode = currentNode.next {
+		if currentNode != l.head {
+			builder.WriteString(" ")
+		}
+		builder.WriteString(fmt.Sprint(currentNode.value))
+	}
+
+	builder.WriteString("]")
+
+	return builder.String()
+}
+
+// All returns an iterator over DoublyLinkedList's index-value pairs
+// from front to back.
+func (l *DoublyLinkedList[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for currentNode := l.head; currentNode != nil; currentNode = currentNode.next {
+			if !yield(i, currentNode.value) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// Backward returns an iterator over DoublyLinkedList's index-value
+// pairs from back to front.
+func (l *DoublyLinkedList[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := l.len - 1
+		for currentNode := l.tail; currentNode != nil; currentNode = currentNode.prev {
+			if !yield(i, currentNode.value) {
+				return
+			}
+			i--
+		}
+	}
+}
+
+// Values returns an iterator over DoublyLinkedList's elements.
+func (l *DoublyLinkedList[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, value := range l.All() {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// nodeAt returns the node at index, which must be in range [0, l.len),
+// walking from the closest end.
+func (l *DoublyLinkedList[T]) nodeAt(index int) *doublyNode[T] {
+	if index < l.len/2 {
+		currentNode := l.head
+		for range index {
+			currentNode = currentNode.next
+		}
+		return currentNode
+	}
+
+	currentNode := l.tail
+	for range l.len - 1 - index {
+		currentNode = currentNode.prev
+	}
+	return currentNode
+}
+
+// unlink removes n from DoublyLinkedList.
+func (l *DoublyLinkedList[T]) unlink(n *doublyNode[T]) {
+	if n.prev == nil {
+		l.head = n.next
+	} else {
+		n.prev.next = n.next
+	}
+
+	if n.next == nil {
+		l.tail = n.prev
+	} else {
+		n.next.prev = n.prev
+	}
+
+	n.prev, n.next = nil, nil
+	l.len--
+}
@@ -0,0 +1,221 @@
+package lists
+
+import (
+	"math/rand/v2"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+// doublyFrom returns a DoublyLinkedList with values pushed to its back.
+func doublyFrom[T comparable](values ...T) *DoublyLinkedList[T] {
+	l := &DoublyLinkedList[T]{}
+	for _, v := range values {
+		l.PushBack(v)
+	}
+
+	return l
+}
+
+// checkDoubly reports whether l's links are consistent in
+// both directions and hold want.
+func checkDoubly[T comparable](l *DoublyLinkedList[T], want []T) bool {
+	var forward, backward []T
+
+	for n := l.head; n != nil; n = n.next {
+		if n.next != nil && n.next.prev != n {
+			return false
+		}
+		forward = append(forward, n.value)
+	}
+	for n := l.tail; n != nil; n = n.prev {
+		backward = append(backward, n.value)
+	}
+	slices.Reverse(backward)
+
+	return l.len == len(want) && slices.Equal(forward, want) && slices.Equal(backward, want)
+}
+
+func TestDoublyLinkedListOutOfBounds(t *testing.T) {
+	tests := []struct {
+		ll    *DoublyLinkedList[string]
+		index int
+	}{
+		{doublyFrom[string](), -1},
+		{doublyFrom[string](), 0},
+		{doublyFrom("a"), 1},
+		{doublyFrom("a"), 2},
+	}
+
+	for i, test := range tests {
+		if !panics(func() { test.ll.Read(test.index) }) {
+			t.Errorf("%d: %v.Read(%d) expected to panic", i, test.ll, test.index)
+		}
+		if !panics(func() { test.ll.Delete(test.index) }) {
+			t.Errorf("%d: %v.Delete(%d) expected to panic", i, test.ll, test.index)
+		}
+
+		index := test.index
+		if index == test.ll.len {
+			index++
+		}
+		if !panics(func() { test.ll.Insert("", index) }) {
+			t.Errorf("%d: %v.Insert(%d) expected to panic", i, test.ll, index)
+		}
+	}
+}
+
+func TestDoublyLinkedListPush(t *testing.T) {
+	l := &DoublyLinkedList[string]{}
+
+	l.PushBack("b")
+	l.PushFront("a")
+	l.PushBack("c")
+
+	if want := []string{"a", "b", "c"}; !checkDoubly(l, want) {
+		t.Errorf("PushFront and PushBack = %v, want %v", l, want)
+	}
+}
+
+func TestDoublyLinkedListPop(t *testing.T) {
+	l := doublyFrom("a", "b", "c")
+
+	if v, ok := l.PopFront(); v != "a" || !ok {
+		t.Errorf("PopFront() = (%q, %v), want (\"a\", true)", v, ok)
+	}
+	if v, ok := l.PopBack(); v != "c" || !ok {
+		t.Errorf("PopBack() = (%q, %v), want (\"c\", true)", v, ok)
+	}
+	if v, ok := l.PopBack(); v != "b" || !ok {
+		t.Errorf("PopBack() = (%q, %v), want (\"b\", true)", v, ok)
+	}
+	if !checkDoubly(l, nil) || l.head != nil || l.tail != nil {
+		t.Errorf("%v is not empty", l)
+	}
+	if v, ok := l.PopFront(); v != "" || ok {
+		t.Errorf("PopFront() = (%q, %v), want (\"\", false)", v, ok)
+	}
+	if v, ok := l.PopBack(); v != "" || ok {
+		t.Errorf("PopBack() = (%q, %v), want (\"\", false)", v, ok)
+	}
+}
+
+func TestDoublyLinkedList(t *testing.T) {
+	l := doublyFrom("a", "b", "c", "d")
+
+	t.Run("Read", func(t *testing.T) {
+		for i, want := range []string{"a", "b", "c", "d"} {
+			if got := l.Read(i); got != want {
+				t.Errorf("%v.Read(%d) = %s, want %s", l, i, got, want)
+			}
+		}
+	})
+
+	t.Run("Search", func(t *testing.T) {
+		tests := []struct {
+			value string
+			want  int
+		}{
+			{"", -1},
+			{"a", 0},
+			{"d", 3},
+			{"e", -1},
+		}
+
+		for i, test := range tests {
+			if got := l.Search(test.value); got != test.want {
+				t.Errorf("%d: %v.Search(%s) = %d, want %d", i, l, test.value, got, test.want)
+			}
+		}
+	})
+
+	t.Run("Backward", func(t *testing.T) {
+		want := []collected[int, string]{{3, "d"}, {2, "c"}, {1, "b"}, {0, "a"}}
+
+		if got := collect(l.Backward()); !reflect.DeepEqual(got, want) {
+			t.Errorf("%v.Backward() = %v, want %v", l, got, want)
+		}
+	})
+
+	t.Run("String", func(t *testing.T) {
+		if got, want := l.String(), "DoublyLinkedList[a b c d]"; got != want {
+			t.Errorf("String() = %q, want %q", got, want)
+		}
+	})
+}
+
+func TestDoublyLinkedListInsert(t *testing.T) {
+	tests := []struct {
+		ll    []string
+		value string
+		index int
+		want  []string
+	}{
+		{nil, "a", 0, []string{"a"}},
+		{[]string{"b"}, "a", 0, []string{"a", "b"}},
+		{[]string{"a"}, "b", 1, []string{"a", "b"}},
+		{[]string{"a", "c"}, "b", 1, []string{"a", "b", "c"}},
+		{[]string{"a", "b", "c", "e"}, "d", 3, []string{"a", "b", "c", "d", "e"}},
+	}
+
+	for i, test := range tests {
+		l := doublyFrom(test.ll...)
+		l.Insert(test.value, test.index)
+
+		if !checkDoubly(l, test.want) {
+			t.Errorf("%d: %v.Insert(%s, %d) = %v, want %v", i, test.ll, test.value, test.index, l, test.want)
+		}
+	}
+}
+
+func TestDoublyLinkedListDelete(t *testing.T) {
+	tests := []struct {
+		ll    []string
+		index int
+		want  []string
+	}{
+		{[]string{"a"}, 0, nil},
+		{[]string{"a", "b"}, 0, []string{"b"}},
+		{[]string{"a", "b"}, 1, []string{"a"}},
+		{[]string{"a", "b", "c", "d", "e"}, 3, []string{"a", "b", "c", "e"}},
+	}
+
+	for i, test := range tests {
+		l := doublyFrom(test.ll...)
+		l.Delete(test.index)
+
+		if !checkDoubly(l, test.want) {
+			t.Errorf("%d: %v.Delete(%d) = %v, want %v", i, test.ll, test.index, l, test.want)
+		}
+	}
+}
+
+// TestListsInterchangeable runs the same random operations on every
+// List implementation and checks they all hold the same values.
+func TestListsInterchangeable(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	lists := []List[int]{&LinkedList[int]{}, &DoublyLinkedList[int]{}, &UnrolledList[int]{}}
+	var want []int
+
+	for i := range 2000 {
+		if len(want) > 0 && r.IntN(3) == 0 {
+			index := r.IntN(len(want))
+			want = slices.Delete(want, index, index+1)
+			for _, l := range lists {
+				l.Delete(index)
+			}
+		} else {
+			index := r.IntN(len(want) + 1)
+			want = slices.Insert(want, index, i)
+			for _, l := range lists {
+				l.Insert(i, index)
+			}
+		}
+	}
+
+	for _, l := range lists {
+		if got := slices.Collect(l.Values()); !slices.Equal(got, want) || l.Len() != len(want) {
+			t.Errorf("%T holds %v, want %v", l, got, want)
+		}
+	}
+}
@@ -1,37 +1,48 @@
 package lists
 
 import (
+	"errors"
 	"fmt"
 	"iter"
 	"strings"
 )
 
+var _ List[int] = &LinkedList[int]{}
+
+// Errors reported by [LinkedList.Validate].
+var (
+	ErrCycle  = errors.New("lists: cycle detected")
+	ErrLength = errors.New("lists: length mismatch")
+	ErrTail   = errors.New("lists: tail is not the last node")
+)
+
 type LinkedList[T comparable] struct {
 	len  int
 	head *node[T]
+	tail *node[T]
+	// version changes whenever nodes are moved out of LinkedList,
+	// so Cursors taken before are detected as stale.
+	version int
 }
 
 type node[T comparable] struct {
 	value T
 	next  *node[T]
+	// removed marks a node unlinked from its list,
+	// so Cursors still pointing to it are detected.
+	removed bool
 }
 
 // Read returns the value at the provided index.
 // It panic if index is out of bounds.
 //
-// Time O(n) and space O(1).
+// Time O(n) and space O(1), stopping at index.
 func (l *LinkedList[T]) Read(index int) T {
 	if index < 0 || index >= l.len {
 		panic(fmt.Sprintf("index out of range [%d] with length %d", index, l.len))
 	}
 
-	for i, v := range l.all() {
-		if i == index {
-			return v.value
-		}
-	}
-
-	panic("unreachable")
+	return l.nodeAt(index).value
 }
 
 // Search returns the first index that contains value or -1.
@@ -50,63 +61,261 @@ func (l *LinkedList[T]) Search(value T) int {
 // Insert inserts value at the provided index.
 // It panics if index is out of range.
 //
-// Time O(n) and space O(1).
+// Time O(n) and space O(1), stopping before index.
+// Inserting at either end is O(1).
 func (l *LinkedList[T]) Insert(value T, index int) {
 	if index < 0 || index > l.len {
 		panic(fmt.Sprintf("index out of range [%d] with length %d", index, l.len))
 	}
 
+	if index == 0 {
+		l.Prepend(value)
+		return
+	}
+	if index == l.len {
+		l.Append(value)
+		return
+	}
+
+	prevNode := l.nodeAt(index - 1)
+	prevNode.next = &node[T]{
+		value: value,
+		next:  prevNode.next,
+	}
 	l.len++
+}
+
+// Append adds value to the end of LinkedList.
+//
+// Time O(1) and space O(1).
+func (l *LinkedList[T]) Append(value T) {
 	newNode := &node[T]{
 		value: value,
 		next:  nil,
 	}
 
-	if index == 0 {
-		newNode.next = l.head
+	if l.tail == nil {
 		l.head = newNode
-		return
+	} else {
+		l.tail.next = newNode
 	}
 
-	var prevNode *node[T]
-	for i, v := range l.all() {
-		if i == index-1 {
-			prevNode = v
-		}
+	l.tail = newNode
+	l.len++
+}
+
+// Prepend adds value to the beginning of LinkedList.
+//
+// Time O(1) and space O(1).
+func (l *LinkedList[T]) Prepend(value T) {
+	l.head = &node[T]{
+		value: value,
+		next:  l.head,
 	}
 
-	newNode.next = prevNode.next
-	prevNode.next = newNode
+	if l.tail == nil {
+		l.tail = l.head
+	}
+
+	l.len++
+}
+
+// PopFront attempts to remove and return the first value
+// of LinkedList and reports whether it succeeded.
+//
+// Time O(1) and space O(1).
+func (l *LinkedList[T]) PopFront() (T, bool) {
+	if l.head == nil {
+		var v T
+		return v, false
+	}
+
+	value := l.head.value
+	l.Delete(0)
+
+	return value, true
 }
 
 // Delete removes value at provided index.
 // It panics if index is out of bounds.
 //
-// Time O(n) and space O(1).
+// Time O(n) and space O(1), stopping before index.
+// Deleting the first value is O(1).
 func (l *LinkedList[T]) Delete(index int) {
 	if index < 0 || index >= l.len {
 		panic(fmt.Sprintf("index out of range [%d] with length %d", index, l.len))
 	}
 
-	l.len--
-	if l.len == 0 {
-		l.head = nil
+	if index == 0 {
+		l.unlinkAfter(nil)
 		return
 	}
 
-	if index == 0 {
-		l.head = l.head.next
-		return
+	l.unlinkAfter(l.nodeAt(index - 1))
+}
+
+// DeleteValue removes the first occurrence of value and reports
+// whether it was found.
+//
+// Time O(n) and space O(1).
+func (l *LinkedList[T]) DeleteValue(value T) bool {
+	var prevNode *node[T]
+
+	for currentNode := l.head; currentNode != nil; currentNode = currentNode.next {
+		if currentNode.value == value {
+			l.unlinkAfter(prevNode)
+			return true
+		}
+		prevNode = currentNode
+	}
+
+	return false
+}
+
+// RemoveIf removes every value for which pred returns true
+// and returns how many values were removed.
+//
+// Time O(n) and space O(1).
+func (l *LinkedList[T]) RemoveIf(pred func(T) bool) int {
+	removed := 0
+
+	var prevNode *node[T]
+	for currentNode := l.head; currentNode != nil; {
+		nextNode := currentNode.next
+
+		if pred(currentNode.value) {
+			l.unlinkAfter(prevNode)
+			removed++
+		} else {
+			prevNode = currentNode
+		}
+
+		currentNode = nextNode
 	}
 
+	return removed
+}
+
+// Reverse reverses the order of LinkedList's values in-place.
+//
+// Time O(n) and space O(1).
+func (l *LinkedList[T]) Reverse() {
 	var prevNode *node[T]
-	for i, v := range l.all() {
-		if i == index-1 {
-			prevNode = v
+
+	l.tail = l.head
+	for currentNode := l.head; currentNode != nil; {
+		nextNode := currentNode.next
+		currentNode.next = prevNode
+		prevNode, currentNode = currentNode, nextNode
+	}
+
+	l.head = prevNode
+}
+
+// Concat moves every node of other to the end of LinkedList,
+// leaving other empty and every Cursor to other stale.
+// It panics if other is LinkedList itself.
+//
+// Time O(1) and space O(1).
+func (l *LinkedList[T]) Concat(other *LinkedList[T]) {
+	l.Splice(l.len, other)
+}
+
+// Splice moves every node of other into LinkedList starting at
+// the provided index, leaving other empty and every Cursor to other stale.
+// It panics if index is out of range or if other is LinkedList itself.
+//
+// Time O(n) and space O(1), stopping before index.
+// Splicing at either end is O(1).
+func (l *LinkedList[T]) Splice(index int, other *LinkedList[T]) {
+	if index < 0 || index > l.len {
+		panic(fmt.Sprintf("index out of range [%d] with length %d", index, l.len))
+	}
+	if l == other {
+		panic("splice of a list into itself")
+	}
+
+	if other.len == 0 {
+		return
+	}
+
+	switch {
+	case index == 0:
+		other.tail.next = l.head
+		l.head = other.head
+		if l.tail == nil {
+			l.tail = other.tail
 		}
+	case index == l.len:
+		l.tail.next = other.head
+		l.tail = other.tail
+	default:
+		prevNode := l.nodeAt(index - 1)
+		other.tail.next = prevNode.next
+		prevNode.next = other.head
 	}
 
-	prevNode.next = prevNode.next.next
+	l.len += other.len
+	*other = LinkedList[T]{version: other.version + 1}
+}
+
+// SplitAt moves LinkedList's nodes into two new lists, the first
+// holding values before index and the second the remaining ones,
+// leaving LinkedList empty and every Cursor to it stale.
+// It panics if index is out of range.
+//
+// Time O(n) and space O(1), stopping before index.
+func (l *LinkedList[T]) SplitAt(index int) (*LinkedList[T], *LinkedList[T]) {
+	if index < 0 || index > l.len {
+		panic(fmt.Sprintf("index out of range [%d] with length %d", index, l.len))
+	}
+
+	first, second := &LinkedList[T]{}, &LinkedList[T]{}
+
+	switch index {
+	case 0:
+		*second = *l
+	case l.len:
+		*first = *l
+	default:
+		prevNode := l.nodeAt(index - 1)
+
+		*second = LinkedList[T]{
+			len:  l.len - index,
+			head: prevNode.next,
+			tail: l.tail,
+		}
+		*first = LinkedList[T]{
+			len:  index,
+			head: l.head,
+			tail: prevNode,
+		}
+		prevNode.next = nil
+	}
+
+	*l = LinkedList[T]{version: l.version + 1}
+
+	return first, second
+}
+
+// Equal reports whether LinkedList and other hold the same values
+// in the same order.
+//
+// Time O(n) and space O(1).
+func (l *LinkedList[T]) Equal(other *LinkedList[T]) bool {
+	if l.len != other.len {
+		return false
+	}
+
+	n1, n2 := l.head, other.head
+	for n1 != nil && n2 != nil {
+		if n1.value != n2.value {
+			return false
+		}
+		n1, n2 = n1.next, n2.next
+	}
+
+	return n1 == nil && n2 == nil
 }
 
 // Len returns LinkedList's length.
@@ -114,6 +323,54 @@ func (l *LinkedList[T]) Len() int {
 	return l.len
 }
 
+// Validate checks LinkedList's internal consistency, which can only be
+// broken by linking nodes by hand. It reports, in this order, a cycle
+// found with Floyd's algorithm ([ErrCycle]), a cached length that does
+// not match the number of nodes ([ErrLength]) or a tail pointer that
+// is not the last node ([ErrTail]).
+//
+// Time O(n) and space O(1).
+func (l *LinkedList[T]) Validate() error {
+	slow, fast := l.head, l.head
+	for fast != nil && fast.next != nil {
+		slow, fast = slow.next, fast.next.next
+
+		if slow == fast {
+			// restarting one pointer from head makes both meet
+			// at the first node of the cycle
+			start := 0
+			for slow = l.head; slow != fast; start++ {
+				slow, fast = slow.next, fast.next
+			}
+
+			length := 1
+			for fast = slow.next; fast != slow; fast = fast.next {
+				length++
+			}
+
+			return fmt.Errorf("%w: node %d links back to node %d, forming a cycle of length %d",
+				ErrCycle, start+length-1, start, length)
+		}
+	}
+
+	count := 0
+	var last *node[T]
+	for currentNode := l.head; currentNode != nil; currentNode = currentNode.next {
+		last = currentNode
+		count++
+	}
+
+	if count != l.len {
+		return fmt.Errorf("%w: cached length %d, counted %d nodes", ErrLength, l.len, count)
+	}
+
+	if l.tail != last {
+		return fmt.Errorf("%w: tail holds %v", ErrTail, describe(l.tail))
+	}
+
+	return nil
+}
+
 func (l *LinkedList[T]) String() string {
 	var builder strings.Builder
 
@@ -138,6 +395,52 @@ func (l *LinkedList[T]) String() string {
 	return builder.String()
 }
 
+// nodeAt returns the node at index, which must be in range [0, l.len).
+// The last node is returned in O(1).
+func (l *LinkedList[T]) nodeAt(index int) *node[T] {
+	if index == l.len-1 {
+		return l.tail
+	}
+
+	currentNode := l.head
+	for range index {
+		currentNode = currentNode.next
+	}
+
+	return currentNode
+}
+
+// describe formats n for error messages.
+func describe[T comparable](n *node[T]) string {
+	if n == nil {
+		return "nil"
+	}
+
+	return fmt.Sprintf("node with value %v", n.value)
+}
+
+// unlinkAfter removes the node following prevNode, or the head
+// if prevNode is nil.
+func (l *LinkedList[T]) unlinkAfter(prevNode *node[T]) {
+	var removed *node[T]
+
+	if prevNode == nil {
+		removed = l.head
+		l.head = removed.next
+	} else {
+		removed = prevNode.next
+		prevNode.next = removed.next
+	}
+
+	if l.tail == removed {
+		l.tail = prevNode
+	}
+
+	removed.next = nil
+	removed.removed = true
+	l.len--
+}
+
 // all returns an iterator over LinkedList's index-node pairs.
 func (l *LinkedList[T]) all() iter.Seq2[int, *node[T]] {
 	return func(yield func(int, *node[T]) bool) {
@@ -1,7 +1,11 @@
 package lists
 
 import (
+	"errors"
 	"fmt"
+	"iter"
+	"reflect"
+	"slices"
 	"testing"
 )
 
@@ -17,11 +21,26 @@ func panics(fn func()) (panicked bool) {
 	return panicked
 }
 
+// withTail sets l's tail pointer to its last node and returns l,
+// so fixtures can be written by linking nodes from head only.
+func withTail[T comparable](l *LinkedList[T]) *LinkedList[T] {
+	l.tail = nil
+	for n := l.head; n != nil; n = n.next {
+		l.tail = n
+	}
+
+	return l
+}
+
 func equals[T comparable](l1, l2 *LinkedList[T]) bool {
 	if l1.len != l2.len {
 		return false
 	}
 
+	if l1.tail != withTail(&LinkedList[T]{head: l1.head}).tail {
+		return false
+	}
+
 	if l1.len == 0 && (l1.head != nil || l2.head != nil) {
 		return false
 	}
@@ -110,7 +129,7 @@ func TestOutOfBounds(t *testing.T) {
 }
 
 func Test(t *testing.T) {
-	l := &LinkedList[string]{
+	l := withTail(&LinkedList[string]{
 		head: &node[string]{
 			value: "a",
 			next: &node[string]{
@@ -122,7 +141,7 @@ func Test(t *testing.T) {
 			},
 		},
 		len: 3,
-	}
+	})
 
 	t.Run("Read", func(t *testing.T) {
 		tests := []struct {
@@ -194,14 +213,14 @@ func TestInsert(t *testing.T) {
 	}{
 		{
 			&LinkedList[string]{
-				0,
-				nil,
+				len:  0,
+				head: nil,
 			},
 			"b",
 			0,
 			&LinkedList[string]{
-				1,
-				&node[string]{
+				len: 1,
+				head: &node[string]{
 					value: "b",
 					next:  nil,
 				},
@@ -209,8 +228,8 @@ func TestInsert(t *testing.T) {
 		},
 		{
 			&LinkedList[string]{
-				1,
-				&node[string]{
+				len: 1,
+				head: &node[string]{
 					value: "b",
 					next:  nil,
 				},
@@ -218,8 +237,8 @@ func TestInsert(t *testing.T) {
 			"a",
 			0,
 			&LinkedList[string]{
-				2,
-				&node[string]{
+				len: 2,
+				head: &node[string]{
 					value: "a",
 					next: &node[string]{
 						value: "b",
@@ -230,8 +249,8 @@ func TestInsert(t *testing.T) {
 		},
 		{
 			&LinkedList[string]{
-				2,
-				&node[string]{
+				len: 2,
+				head: &node[string]{
 					value: "a",
 					next: &node[string]{
 						value: "b",
@@ -242,8 +261,8 @@ func TestInsert(t *testing.T) {
 			"d",
 			2,
 			&LinkedList[string]{
-				3,
-				&node[string]{
+				len: 3,
+				head: &node[string]{
 					value: "a",
 					next: &node[string]{
 						value: "b",
@@ -257,8 +276,8 @@ func TestInsert(t *testing.T) {
 		},
 		{
 			&LinkedList[string]{
-				3,
-				&node[string]{
+				len: 3,
+				head: &node[string]{
 					value: "a",
 					next: &node[string]{
 						value: "b",
@@ -272,8 +291,8 @@ func TestInsert(t *testing.T) {
 			"c",
 			2,
 			&LinkedList[string]{
-				4,
-				&node[string]{
+				len: 4,
+				head: &node[string]{
 					value: "a",
 					next: &node[string]{
 						value: "b",
@@ -292,7 +311,7 @@ func TestInsert(t *testing.T) {
 
 	for i, test := range tests {
 		before := fmt.Sprint(test.ll)
-		test.ll.Insert(test.value, test.index)
+		withTail(test.ll).Insert(test.value, test.index)
 
 		if !equals(test.ll, test.want) {
 			t.Errorf("%d: %v.Insert(%s, %d) = %s, got %s", i, before, test.value, test.index, test.want, test.ll)
@@ -308,22 +327,22 @@ func TestDelete(t *testing.T) {
 	}{
 		{
 			&LinkedList[string]{
-				1,
-				&node[string]{
+				len: 1,
+				head: &node[string]{
 					value: "a",
 					next:  nil,
 				},
 			},
 			0,
 			&LinkedList[string]{
-				0,
-				nil,
+				len:  0,
+				head: nil,
 			},
 		},
 		{
 			&LinkedList[string]{
-				2,
-				&node[string]{
+				len: 2,
+				head: &node[string]{
 					value: "a",
 					next: &node[string]{
 						value: "b",
@@ -333,8 +352,8 @@ func TestDelete(t *testing.T) {
 			},
 			0,
 			&LinkedList[string]{
-				1,
-				&node[string]{
+				len: 1,
+				head: &node[string]{
 					value: "b",
 					next:  nil,
 				},
@@ -342,8 +361,8 @@ func TestDelete(t *testing.T) {
 		},
 		{
 			&LinkedList[string]{
-				2,
-				&node[string]{
+				len: 2,
+				head: &node[string]{
 					value: "a",
 					next: &node[string]{
 						value: "b",
@@ -353,8 +372,8 @@ func TestDelete(t *testing.T) {
 			},
 			1,
 			&LinkedList[string]{
-				1,
-				&node[string]{
+				len: 1,
+				head: &node[string]{
 					value: "a",
 					next:  nil,
 				},
@@ -364,7 +383,7 @@ func TestDelete(t *testing.T) {
 
 	for i, test := range tests {
 		before := fmt.Sprint(test.ll)
-		test.ll.Delete(test.index)
+		withTail(test.ll).Delete(test.index)
 
 		if !equals(test.ll, test.want) {
 			t.Errorf("%d: %v.Delete(%d) = %v, got %v", i, before, test.index, test.want, test.ll)
@@ -424,3 +443,407 @@ func TestAll(t *testing.T) {
 		}
 	}
 }
+
+func TestAppendPrepend(t *testing.T) {
+	l := &LinkedList[string]{}
+
+	l.Append("b")
+	l.Prepend("a")
+	l.Append("c")
+	l.Insert("d", 3)
+
+	want := withTail(&LinkedList[string]{
+		len: 4,
+		head: &node[string]{
+			value: "a",
+			next: &node[string]{
+				value: "b",
+				next: &node[string]{
+					value: "c",
+					next: &node[string]{
+						value: "d",
+						next:  nil,
+					},
+				},
+			},
+		},
+	})
+
+	if !equals(l, want) {
+		t.Errorf("Append and Prepend = %v, want %v", l, want)
+	}
+}
+
+func TestPopFront(t *testing.T) {
+	l := &LinkedList[string]{}
+	l.Append("a")
+	l.Append("b")
+
+	if v, ok := l.PopFront(); v != "a" || !ok {
+		t.Errorf("PopFront() = (%q, %v), want (\"a\", true)", v, ok)
+	}
+	if v, ok := l.PopFront(); v != "b" || !ok {
+		t.Errorf("PopFront() = (%q, %v), want (\"b\", true)", v, ok)
+	}
+	if v, ok := l.PopFront(); v != "" || ok || !equals(l, &LinkedList[string]{}) {
+		t.Errorf("PopFront() = (%q, %v) and %v, want (\"\", false) and LinkedList[]", v, ok, l)
+	}
+
+	// tail must be reset, otherwise Append would link to a removed node
+	l.Append("c")
+	if got, want := l.String(), "LinkedList[c]"; got != want {
+		t.Errorf("Append after PopFront = %s, want %s", got, want)
+	}
+}
+
+func TestDeleteTail(t *testing.T) {
+	l := &LinkedList[int]{}
+	for i := range 3 {
+		l.Append(i)
+	}
+
+	l.Delete(2)
+	l.Append(3)
+
+	if got, want := l.String(), "LinkedList[0 1 3]"; got != want || l.tail.value != 3 {
+		t.Errorf("Delete(2) and Append(3) = %s, want %s", got, want)
+	}
+}
+
+func BenchmarkLinkedList(b *testing.B) {
+	const length = 10000
+
+	b.Run(fmt.Sprintf("Insert at end size = %d", length), func(b *testing.B) {
+		b.ReportAllocs()
+		for range b.N {
+			l := &LinkedList[int]{}
+			for i := range length {
+				l.Insert(i, l.Len())
+			}
+		}
+	})
+
+	b.Run(fmt.Sprintf("Append size = %d", length), func(b *testing.B) {
+		b.ReportAllocs()
+		for range b.N {
+			l := &LinkedList[int]{}
+			for i := range length {
+				l.Append(i)
+			}
+		}
+	})
+
+	b.Run(fmt.Sprintf("Read first size = %d", length), func(b *testing.B) {
+		l := &LinkedList[int]{}
+		for i := range length {
+			l.Append(i)
+		}
+
+		b.ResetTimer()
+		for range b.N {
+			l.Read(0)
+		}
+	})
+
+	b.Run(fmt.Sprintf("Delete second size = %d", length), func(b *testing.B) {
+		l := &LinkedList[int]{}
+		for i := range length {
+			l.Append(i)
+		}
+
+		b.ResetTimer()
+		for i := range b.N {
+			l.Delete(1)
+			l.Append(i)
+		}
+	})
+}
+
+// linkedFrom returns a LinkedList with values appended in order.
+func linkedFrom[T comparable](values ...T) *LinkedList[T] {
+	l := &LinkedList[T]{}
+	for _, v := range values {
+		l.Append(v)
+	}
+
+	return l
+}
+
+func TestReverse(t *testing.T) {
+	tests := []struct {
+		ll   *LinkedList[int]
+		want *LinkedList[int]
+	}{
+		{linkedFrom[int](), linkedFrom[int]()},
+		{linkedFrom(1), linkedFrom(1)},
+		{linkedFrom(1, 2, 3), linkedFrom(3, 2, 1)},
+	}
+
+	for i, test := range tests {
+		before := fmt.Sprint(test.ll)
+		test.ll.Reverse()
+
+		if !equals(test.ll, test.want) {
+			t.Errorf("%d: %s.Reverse() = %v, want %v", i, before, test.ll, test.want)
+		}
+	}
+}
+
+func TestSplice(t *testing.T) {
+	tests := []struct {
+		ll    *LinkedList[int]
+		index int
+		other *LinkedList[int]
+		want  *LinkedList[int]
+	}{
+		{linkedFrom[int](), 0, linkedFrom[int](), linkedFrom[int]()},
+		{linkedFrom[int](), 0, linkedFrom(1, 2), linkedFrom(1, 2)},
+		{linkedFrom(3), 0, linkedFrom(1, 2), linkedFrom(1, 2, 3)},
+		{linkedFrom(1), 1, linkedFrom(2, 3), linkedFrom(1, 2, 3)},
+		{linkedFrom(1, 4), 1, linkedFrom(2, 3), linkedFrom(1, 2, 3, 4)},
+		{linkedFrom(1, 2), 1, linkedFrom[int](), linkedFrom(1, 2)},
+	}
+
+	for i, test := range tests {
+		before := fmt.Sprint(test.ll)
+		test.ll.Splice(test.index, test.other)
+
+		if !equals(test.ll, test.want) || !equals(test.other, linkedFrom[int]()) {
+			t.Errorf("%d: %s.Splice(%d) = %v and %v, want %v", i, before, test.index, test.ll, test.other, test.want)
+		}
+	}
+
+	l := linkedFrom(1)
+	if !panics(func() { l.Concat(l) }) {
+		t.Errorf("%v.Concat(itself) expected to panic", l)
+	}
+	if !panics(func() { l.Splice(2, linkedFrom(2)) }) {
+		t.Errorf("%v.Splice(2) expected to panic", l)
+	}
+}
+
+func TestConcat(t *testing.T) {
+	l, other := linkedFrom(1, 2), linkedFrom(3)
+	l.Concat(other)
+	l.Append(4)
+
+	if want := linkedFrom(1, 2, 3, 4); !equals(l, want) || !equals(other, linkedFrom[int]()) {
+		t.Errorf("Concat() = %v and %v, want %v and LinkedList[]", l, other, want)
+	}
+}
+
+func TestSplitAt(t *testing.T) {
+	tests := []struct {
+		ll         *LinkedList[int]
+		index      int
+		wantFirst  *LinkedList[int]
+		wantSecond *LinkedList[int]
+	}{
+		{linkedFrom[int](), 0, linkedFrom[int](), linkedFrom[int]()},
+		{linkedFrom(1, 2), 0, linkedFrom[int](), linkedFrom(1, 2)},
+		{linkedFrom(1, 2), 2, linkedFrom(1, 2), linkedFrom[int]()},
+		{linkedFrom(1, 2, 3), 1, linkedFrom(1), linkedFrom(2, 3)},
+	}
+
+	for i, test := range tests {
+		before := fmt.Sprint(test.ll)
+		first, second := test.ll.SplitAt(test.index)
+
+		if !equals(first, test.wantFirst) || !equals(second, test.wantSecond) || !equals(test.ll, linkedFrom[int]()) {
+			t.Errorf("%d: %s.SplitAt(%d) = (%v, %v), want (%v, %v)",
+				i, before, test.index, first, second, test.wantFirst, test.wantSecond)
+		}
+	}
+
+	if !panics(func() { linkedFrom(1).SplitAt(2) }) {
+		t.Errorf("SplitAt(2) expected to panic")
+	}
+}
+
+func TestRemoveIf(t *testing.T) {
+	even := func(v int) bool { return v%2 == 0 }
+
+	tests := []struct {
+		ll          *LinkedList[int]
+		want        *LinkedList[int]
+		wantRemoved int
+	}{
+		{linkedFrom[int](), linkedFrom[int](), 0},
+		{linkedFrom(2, 4), linkedFrom[int](), 2},
+		{linkedFrom(1, 2, 3, 4), linkedFrom(1, 3), 2},
+		{linkedFrom(2, 1, 3), linkedFrom(1, 3), 1},
+	}
+
+	for i, test := range tests {
+		before := fmt.Sprint(test.ll)
+
+		if got := test.ll.RemoveIf(even); got != test.wantRemoved || !equals(test.ll, test.want) {
+			t.Errorf("%d: %s.RemoveIf(even) = (%d, %v), want (%d, %v)", i, before, got, test.ll, test.wantRemoved, test.want)
+		}
+	}
+}
+
+func TestDeleteValue(t *testing.T) {
+	tests := []struct {
+		ll    *LinkedList[string]
+		value string
+		want  *LinkedList[string]
+		found bool
+	}{
+		{linkedFrom[string](), "a", linkedFrom[string](), false},
+		{linkedFrom("a"), "a", linkedFrom[string](), true},
+		{linkedFrom("a", "b", "a"), "a", linkedFrom("b", "a"), true},
+		{linkedFrom("a", "b"), "b", linkedFrom("a"), true},
+		{linkedFrom("a", "b"), "c", linkedFrom("a", "b"), false},
+	}
+
+	for i, test := range tests {
+		before := fmt.Sprint(test.ll)
+
+		if got := test.ll.DeleteValue(test.value); got != test.found || !equals(test.ll, test.want) {
+			t.Errorf("%d: %s.DeleteValue(%q) = (%v, %v), want (%v, %v)", i, before, test.value, got, test.ll, test.found, test.want)
+		}
+	}
+}
+
+func TestEqual(t *testing.T) {
+	tests := []struct {
+		l1, l2 *LinkedList[int]
+		want   bool
+	}{
+		{linkedFrom[int](), linkedFrom[int](), true},
+		{linkedFrom(1, 2), linkedFrom(1, 2), true},
+		{linkedFrom(1, 2), linkedFrom(1), false},
+		{linkedFrom(1, 2), linkedFrom(2, 1), false},
+	}
+
+	for i, test := range tests {
+		if got := test.l1.Equal(test.l2); got != test.want {
+			t.Errorf("%d: %v.Equal(%v) = %v, want %v", i, test.l1, test.l2, got, test.want)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	cycle := func(length, start int) *LinkedList[int] {
+		l := linkedFrom[int]()
+		for i := range length {
+			l.Append(i)
+		}
+		l.tail.next = l.nodeAt(start)
+		return l
+	}
+
+	wrongTail := linkedFrom(1, 2)
+	wrongTail.tail = wrongTail.head
+
+	wrongLength := linkedFrom(1, 2)
+	wrongLength.len = 3
+
+	tests := []struct {
+		ll      *LinkedList[int]
+		wantErr error
+		wantMsg string
+	}{
+		{linkedFrom[int](), nil, ""},
+		{linkedFrom(1, 2, 3), nil, ""},
+		{cycle(1, 0), ErrCycle, "lists: cycle detected: node 0 links back to node 0, forming a cycle of length 1"},
+		{cycle(5, 0), ErrCycle, "lists: cycle detected: node 4 links back to node 0, forming a cycle of length 5"},
+		{cycle(5, 3), ErrCycle, "lists: cycle detected: node 4 links back to node 3, forming a cycle of length 2"},
+		{wrongLength, ErrLength, "lists: length mismatch: cached length 3, counted 2 nodes"},
+		{wrongTail, ErrTail, "lists: tail is not the last node: tail holds node with value 1"},
+		{&LinkedList[int]{len: 0, tail: &node[int]{}}, ErrTail, "lists: tail is not the last node: tail holds node with value 0"},
+	}
+
+	for i, test := range tests {
+		err := test.ll.Validate()
+
+		if !errors.Is(err, test.wantErr) || err != nil && err.Error() != test.wantMsg {
+			t.Errorf("%d: Validate() = %v, want %q", i, err, test.wantMsg)
+		}
+	}
+}
+
+// FuzzLinkedList applies operation sequences decoded from the fuzzer
+// input to a LinkedList and a slice, validating the list and
+// comparing both after every operation.
+func FuzzLinkedList(f *testing.F) {
+	f.Add([]byte{0, 0, 1, 1, 2, 2, 3, 3, 4, 4, 5, 5, 6, 6, 7, 7, 8, 8, 9, 9, 10, 10, 11, 11})
+	f.Add([]byte{1, 5, 1, 6, 1, 7, 6, 1, 9, 2, 7, 0, 10, 1, 11, 3})
+	f.Add([]byte{2, 1, 2, 2, 2, 3, 5, 0, 4, 0, 8, 4, 3, 0})
+
+	f.Fuzz(func(t *testing.T, ops []byte) {
+		l := &LinkedList[int]{}
+		var want []int
+
+		for i := 0; i+1 < len(ops); i += 2 {
+			op, arg := ops[i]%12, int(ops[i+1])
+
+			switch op {
+			case 0:
+				index := arg % (len(want) + 1)
+				l.Insert(arg, index)
+				want = slices.Insert(want, index, arg)
+			case 1:
+				l.Append(arg)
+				want = append(want, arg)
+			case 2:
+				l.Prepend(arg)
+				want = slices.Insert(want, 0, arg)
+			case 3:
+				if len(want) > 0 {
+					index := arg % len(want)
+					l.Delete(index)
+					want = slices.Delete(want, index, index+1)
+				}
+			case 4:
+				if _, ok := l.PopFront(); ok {
+					want = want[1:]
+				}
+			case 5:
+				l.Reverse()
+				slices.Reverse(want)
+			case 6:
+				if index := slices.Index(want, arg%8); index != -1 {
+					want = slices.Delete(want, index, index+1)
+				}
+				l.DeleteValue(arg % 8)
+			case 7:
+				pred := func(v int) bool { return v%(arg%4+2) == 0 }
+				l.RemoveIf(pred)
+				want = slices.DeleteFunc(want, pred)
+			case 8:
+				index := arg % (len(want) + 1)
+				other := linkedFrom(arg, arg+1)
+				l.Splice(index, other)
+				want = slices.Insert(want, index, arg, arg+1)
+			case 9:
+				first, second := l.SplitAt(arg % (len(want) + 1))
+				second.Concat(first)
+				l.Concat(second)
+				index := arg % (len(want) + 1)
+				want = append(want[index:], want[:index]...)
+			case 10:
+				if c := l.Find(arg % 8); c.Valid() {
+					c.InsertAfter(arg)
+					index := slices.Index(want, arg%8)
+					want = slices.Insert(want, index+1, arg)
+				}
+			case 11:
+				if c := l.Find(arg % 8); c.Valid() {
+					index := slices.Index(want, arg%8)
+					if _, ok := c.RemoveNext(); ok {
+						want = slices.Delete(want, index+1, index+2)
+					}
+				}
+			}
+
+			if err := l.Validate(); err != nil {
+				t.Fatalf("op %d(%d): Validate() = %v", op, arg, err)
+			}
+			if got := slices.Collect(l.Values()); !slices.Equal(got, want) {
+				t.Fatalf("op %d(%d): list = %v, want %v", op, arg, got, want)
+			}
+		}
+	})
+}
@@ -0,0 +1,27 @@
+// Package lists defines the List interface and all of it's implementations.
+package lists
+
+import "iter"
+
+// List is the interface for list implementations.
+// A List is an abstract data type that is an ordered
+// sequence of elements accessed by index.
+type List[T comparable] interface {
+	// Read returns the value at the provided index.
+	// It panics if index is out of bounds.
+	Read(index int) T
+	// Search returns the first index that contains value or -1.
+	Search(value T) int
+	// Insert inserts value at the provided index.
+	// It panics if index is out of range.
+	Insert(value T, index int)
+	// Delete removes value at provided index.
+	// It panics if index is out of bounds.
+	Delete(index int)
+	// Len returns List's length.
+	Len() int
+	// All returns an iterator over List's index-value pairs.
+	All() iter.Seq2[int, T]
+	// Values returns an iterator over List's elements.
+	Values() iter.Seq[T]
+}
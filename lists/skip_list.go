@@ -0,0 +1,291 @@
+package lists
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"math/rand/v2"
+	"strings"
+)
+
+const (
+	// skipListMaxLevel bounds node heights, enough for 2^32 elements.
+	skipListMaxLevel = 32
+	// skipListP is the probability of a node being promoted a level.
+	skipListP = 0.25
+)
+
+// SkipList is an ordered map implemented as an indexable skip list,
+// a hierarchy of linked lists where each level skips over more nodes,
+// giving O(log(n)) expected time for lookups, updates and rank queries.
+//
+// Keys follow the order of [cmp.Compare], where a float NaN sorts
+// first and equals itself, so a NaN key is stored only once.
+//
+// A SkipList must be created with [NewSkipList]; the zero value is not usable.
+type SkipList[K cmp.Ordered, V any] struct {
+	head  *skipNode[K, V]
+	level int
+	len   int
+	rng   *rand.Rand
+}
+
+type skipNode[K cmp.Ordered, V any] struct {
+	key   K
+	value V
+	next  []*skipNode[K, V]
+	// span[i] is the number of positions next[i] moves forward,
+	// which allows rank and index queries.
+	span []int
+}
+
+// NewSkipList returns an empty SkipList whose node levels are drawn
+// from rng, so a seeded rng gives deterministic structures.
+// If rng is nil, a randomly seeded one is used.
+func NewSkipList[K cmp.Ordered, V any](rng *rand.Rand) *SkipList[K, V] {
+	if rng == nil {
+		rng = rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64()))
+	}
+
+	return &SkipList[K, V]{
+		head: &skipNode[K, V]{
+			next: make([]*skipNode[K, V], skipListMaxLevel),
+			span: make([]int, skipListMaxLevel),
+		},
+		level: 1,
+		rng:   rng,
+	}
+}
+
+// Get returns the value associated with key and reports whether it was found.
+//
+// Time O(log(n)) expected and space O(1).
+func (s *SkipList[K, V]) Get(key K) (V, bool) {
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && cmp.Less(x.next[i].key, key) {
+			x = x.next[i]
+		}
+	}
+
+	if x = x.next[0]; x != nil && cmp.Compare(x.key, key) == 0 {
+		return x.value, true
+	}
+
+	var v V
+	return v, false
+}
+
+// Insert associates value with key and reports whether key is new.
+// If key already exists, its value is replaced.
+//
+// Time O(log(n)) expected and space O(log(n)) expected.
+func (s *SkipList[K, V]) Insert(key K, value V) bool {
+	var (
+		update [skipListMaxLevel]*skipNode[K, V]
+		// rank[i] is the position of update[i], with head at 0
+		rank [skipListMaxLevel]int
+	)
+
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		if i < s.level-1 {
+			rank[i] = rank[i+1]
+		}
+		for x.next[i] != nil && cmp.Less(x.next[i].key, key) {
+			rank[i] += x.span[i]
+			x = x.next[i]
+		}
+		update[i] = x
+	}
+
+	if x.next[0] != nil && cmp.Compare(x.next[0].key, key) == 0 {
+		x.next[0].value = value
+		return false
+	}
+
+	level := s.randomLevel()
+	if level > s.level {
+		for i := s.level; i < level; i++ {
+			rank[i] = 0
+			update[i] = s.head
+			s.head.span[i] = s.len
+		}
+		s.level = level
+	}
+
+	n := &skipNode[K, V]{
+		key:   key,
+		value: value,
+		next:  make([]*skipNode[K, V], level),
+		span:  make([]int, level),
+	}
+
+	// n is placed at position rank[0]+1
+	for i := range level {
+		n.next[i] = update[i].next[i]
+		update[i].next[i] = n
+
+		n.span[i] = update[i].span[i] - (rank[0] - rank[i])
+		update[i].span[i] = rank[0] - rank[i] + 1
+	}
+
+	for i := level; i < s.level; i++ {
+		update[i].span[i]++
+	}
+
+	s.len++
+
+	return true
+}
+
+// Delete removes key and reports whether it was found.
+//
+// Time O(log(n)) expected and space O(1).
+func (s *SkipList[K, V]) Delete(key K) bool {
+	var update [skipListMaxLevel]*skipNode[K, V]
+
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && cmp.Less(x.next[i].key, key) {
+			x = x.next[i]
+		}
+		update[i] = x
+	}
+
+	x = x.next[0]
+	if x == nil || cmp.Compare(x.key, key) != 0 {
+		return false
+	}
+
+	for i := range s.level {
+		if update[i].next[i] == x {
+			update[i].span[i] += x.span[i] - 1
+			update[i].next[i] = x.next[i]
+		} else {
+			update[i].span[i]--
+		}
+	}
+
+	for s.level > 1 && s.head.next[s.level-1] == nil {
+		s.head.span[s.level-1] = 0
+		s.level--
+	}
+
+	s.len--
+
+	return true
+}
+
+// Rank returns the number of keys lower than key, which is
+// the index key has or would have in SkipList.
+//
+// Time O(log(n)) expected and space O(1).
+func (s *SkipList[K, V]) Rank(key K) int {
+	rank := 0
+
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && cmp.Less(x.next[i].key, key) {
+			rank += x.span[i]
+			x = x.next[i]
+		}
+	}
+
+	return rank
+}
+
+// Select returns the key-value pair at the provided index in key order.
+// It panics if index is out of bounds.
+//
+// Time O(log(n)) expected and space O(1).
+func (s *SkipList[K, V]) Select(index int) (K, V) {
+	if index < 0 || index >= s.len {
+		panic(fmt.Sprintf("index out of range [%d] with length %d", index, s.len))
+	}
+
+	// positions start at 1, head is at 0
+	position := index + 1
+	traversed := 0
+
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && traversed+x.span[i] <= position {
+			traversed += x.span[i]
+			x = x.next[i]
+		}
+	}
+
+	return x.key, x.value
+}
+
+// Len returns SkipList's length.
+func (s *SkipList[K, V]) Len() int {
+	return s.len
+}
+
+// All returns an iterator over SkipList's key-value pairs in key order.
+func (s *SkipList[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		s.from(s.head.next[0])(yield)
+	}
+}
+
+// From returns an iterator over SkipList's key-value pairs in key order,
+// starting at the lowest key greater than or equal to lo.
+func (s *SkipList[K, V]) From(lo K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		x := s.head
+		for i := s.level - 1; i >= 0; i-- {
+			for x.next[i] != nil && cmp.Less(x.next[i].key, lo) {
+				x = x.next[i]
+			}
+		}
+
+		s.from(x.next[0])(yield)
+	}
+}
+
+func (s *SkipList[K, V]) String() string {
+	var builder strings.Builder
+
+	// assume each element requires at least three bytes for printing
+	// and one byte for spacing between elements
+	// len("SkipList[]") + (SkipList.len * 4)
+	builder.Grow(10 + (s.len * 4))
+
+	builder.WriteString("SkipList[")
+
+	for x := s.head.next[0]; x != nil; x = x.next[0] {
+		if x != s.head.next[0] {
+			builder.WriteString(" ")
+		}
+		builder.WriteString(fmt.Sprintf("%v:%v", x.key, x.value))
+	}
+
+	builder.WriteString("]")
+
+	return builder.String()
+}
+
+// from returns an iterator over key-value pairs starting at n.
+func (s *SkipList[K, V]) from(n *skipNode[K, V]) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for x := n; x != nil; x = x.next[0] {
+			if !yield(x.key, x.value) {
+				return
+			}
+		}
+	}
+}
+
+// randomLevel returns a level in range [1, skipListMaxLevel] where
+// each level is skipListP times as likely as the previous one.
+func (s *SkipList[K, V]) randomLevel() int {
+	level := 1
+	for level < skipListMaxLevel && s.rng.Float64() < skipListP {
+		level++
+	}
+
+	return level
+}
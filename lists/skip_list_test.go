@@ -0,0 +1,230 @@
+package lists
+
+import (
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestSkipList(t *testing.T) {
+	s := NewSkipList[int, string](rand.New(rand.NewPCG(1, 2)))
+
+	if _, ok := s.Get(1); ok {
+		t.Errorf("%v.Get(1) found a key in empty SkipList", s)
+	}
+
+	for _, k := range []int{5, 1, 3, 9, 7} {
+		if !s.Insert(k, string(rune('a'+k))) {
+			t.Errorf("Insert(%d) = false, want true", k)
+		}
+	}
+
+	if s.Insert(3, "x") {
+		t.Errorf("Insert(3) of existing key = true, want false")
+	}
+	if v, ok := s.Get(3); v != "x" || !ok {
+		t.Errorf("Get(3) = (%q, %v), want (\"x\", true)", v, ok)
+	}
+
+	if got, want := s.String(), "SkipList[1:b 3:x 5:f 7:h 9:j]"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+
+	tests := []struct {
+		lo   int
+		want []int
+	}{
+		{0, []int{1, 3, 5, 7, 9}},
+		{3, []int{3, 5, 7, 9}},
+		{4, []int{5, 7, 9}},
+		{10, nil},
+	}
+
+	for i, test := range tests {
+		var got []int
+		for k := range s.From(test.lo) {
+			got = append(got, k)
+		}
+
+		if !slices.Equal(got, test.want) {
+			t.Errorf("%d: From(%d) = %v, want %v", i, test.lo, got, test.want)
+		}
+	}
+
+	if !s.Delete(5) || s.Delete(5) || s.Len() != 4 {
+		t.Errorf("Delete(5) twice = %v, want one successful removal", s)
+	}
+
+	for _, index := range []int{-1, 4} {
+		if !panics(func() { s.Select(index) }) {
+			t.Errorf("Select(%d) expected to panic", index)
+		}
+	}
+}
+
+func TestSkipListNaN(t *testing.T) {
+	nan := math.NaN()
+
+	s := NewSkipList[float64, int](rand.New(rand.NewPCG(5, 6)))
+	s.Insert(1, 10)
+
+	if !s.Insert(nan, 1) {
+		t.Errorf("Insert(NaN, 1) = false, want true")
+	}
+	if s.Insert(nan, 2) {
+		t.Errorf("Insert(NaN, 2) = true for a present key, want false")
+	}
+	if got := s.Len(); got != 2 {
+		t.Errorf("Len() = %d, want 2", got)
+	}
+	if v, ok := s.Get(nan); v != 2 || !ok {
+		t.Errorf("Get(NaN) = (%d, %v), want (2, true)", v, ok)
+	}
+	if got := s.Rank(1); got != 1 {
+		t.Errorf("Rank(1) = %d, want 1", got)
+	}
+
+	var keys []float64
+	for k := range s.From(nan) {
+		keys = append(keys, k)
+	}
+	if len(keys) != 2 || !math.IsNaN(keys[0]) || keys[1] != 1 {
+		t.Errorf("From(NaN) = %v, want [NaN 1]", keys)
+	}
+
+	if !s.Delete(nan) || s.Delete(nan) {
+		t.Errorf("Delete(NaN) reports wrong results")
+	}
+	if got := s.Len(); got != 1 {
+		t.Errorf("Len() = %d after Delete(NaN), want 1", got)
+	}
+}
+
+func TestSkipListAllAfterEdit(t *testing.T) {
+	s := NewSkipList[int, string](rand.New(rand.NewPCG(3, 4)))
+	s.Insert(2, "b")
+	s.Insert(3, "c")
+
+	keys := func(all func(yield func(int, string) bool)) []int {
+		var got []int
+		for k := range all {
+			got = append(got, k)
+		}
+		return got
+	}
+
+	all := s.All()
+	s.Insert(1, "a")
+	if got, want := keys(all), []int{1, 2, 3}; !slices.Equal(got, want) {
+		t.Errorf("All() created before Insert = %v, want %v", got, want)
+	}
+
+	all = s.All()
+	s.Delete(1)
+	if got, want := keys(all), []int{2, 3}; !slices.Equal(got, want) {
+		t.Errorf("All() created before Delete = %v, want %v", got, want)
+	}
+}
+
+// TestSkipListRandom compares SkipList with a sorted slice of keys
+// through random operations.
+func TestSkipListRandom(t *testing.T) {
+	r := rand.New(rand.NewPCG(3, 4))
+	s := NewSkipList[int, int](rand.New(rand.NewPCG(5, 6)))
+	var keys []int
+
+	for i := range 5000 {
+		k := r.IntN(500)
+		index, found := slices.BinarySearch(keys, k)
+
+		if r.IntN(3) == 0 {
+			if got := s.Delete(k); got != found {
+				t.Fatalf("%d: Delete(%d) = %v, want %v", i, k, got, found)
+			}
+			if found {
+				keys = slices.Delete(keys, index, index+1)
+			}
+		} else {
+			if got := s.Insert(k, -k); got == found {
+				t.Fatalf("%d: Insert(%d) = %v, want %v", i, k, got, !found)
+			}
+			if !found {
+				keys = slices.Insert(keys, index, k)
+			}
+		}
+
+		if s.Len() != len(keys) {
+			t.Fatalf("%d: Len() = %d, want %d", i, s.Len(), len(keys))
+		}
+	}
+
+	var got []int
+	for k, v := range s.All() {
+		if v != -k {
+			t.Fatalf("All() yields %d:%d, want %d:%d", k, v, k, -k)
+		}
+		got = append(got, k)
+	}
+	if !slices.Equal(got, keys) {
+		t.Fatalf("All() = %v, want %v", got, keys)
+	}
+
+	for i, k := range keys {
+		if got := s.Rank(k); got != i {
+			t.Errorf("Rank(%d) = %d, want %d", k, got, i)
+		}
+		if got, _ := s.Select(i); got != k {
+			t.Errorf("Select(%d) = %d, want %d", i, got, k)
+		}
+	}
+
+	if got, want := s.Rank(1000), len(keys); got != want {
+		t.Errorf("Rank(1000) = %d, want %d", got, want)
+	}
+}
+
+func TestSkipListSeed(t *testing.T) {
+	levels := func() []int {
+		s := NewSkipList[int, int](rand.New(rand.NewPCG(7, 8)))
+		for i := range 100 {
+			s.Insert(i, i)
+		}
+
+		var r []int
+		for x := s.head.next[0]; x != nil; x = x.next[0] {
+			r = append(r, len(x.next))
+		}
+		return r
+	}
+
+	if l1, l2 := levels(), levels(); !slices.Equal(l1, l2) {
+		t.Errorf("levels with the same seed differ: %v and %v", l1, l2)
+	}
+}
+
+func BenchmarkSkipList(b *testing.B) {
+	const length = 100000
+
+	b.Run("Insert", func(b *testing.B) {
+		b.ReportAllocs()
+		for range b.N {
+			s := NewSkipList[int, int](rand.New(rand.NewPCG(1, 2)))
+			for i := range length {
+				s.Insert((i*7919)%length, i)
+			}
+		}
+	})
+
+	b.Run("Get", func(b *testing.B) {
+		s := NewSkipList[int, int](rand.New(rand.NewPCG(1, 2)))
+		for i := range length {
+			s.Insert(i, i)
+		}
+
+		b.ResetTimer()
+		for i := range b.N {
+			s.Get(i % length)
+		}
+	})
+}
@@ -0,0 +1,229 @@
+package lists
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+)
+
+var _ List[int] = &UnrolledList[int]{}
+
+// unrolledNodeCap is the number of values each UnrolledList node holds.
+const unrolledNodeCap = 64
+
+// UnrolledList is a [List] implementation where each node stores a small
+// array of values instead of a single one, which reduces allocations and
+// pointer chasing and makes traversals cache-friendly.
+//
+// Nodes are split in half when inserting into a full node, and merged
+// with, or refilled from, their successor when deleting leaves them
+// less than half full.
+//
+// The zero value is an empty UnrolledList ready to use.
+type UnrolledList[T comparable] struct {
+	len  int
+	head *unrolledNode[T]
+	tail *unrolledNode[T]
+}
+
+type unrolledNode[T comparable] struct {
+	values []T
+	next   *unrolledNode[T]
+}
+
+func newUnrolledNode[T comparable]() *unrolledNode[T] {
+	return &unrolledNode[T]{values: make([]T, 0, unrolledNodeCap)}
+}
+
+// Read returns the value at the provided index.
+// It panics if index is out of bounds.
+//
+// Time O(n/k) and space O(1), where k is the node capacity.
+func (l *UnrolledList[T]) Read(index int) T {
+	if index < 0 || index >= l.len {
+		panic(fmt.Sprintf("index out of range [%d] with length %d", index, l.len))
+	}
+
+	_, n, offset := l.locate(index)
+
+	return n.values[offset]
+}
+
+// Search returns the first index that contains value or -1.
+//
+// Time O(n) and space O(1).
+func (l *UnrolledList[T]) Search(value T) int {
+	i := 0
+	for n := l.head; n != nil; n = n.next {
+		if j := slices.Index(n.values, value); j != -1 {
+			return i + j
+		}
+		i += len(n.values)
+	}
+
+	return -1
+}
+
+// Insert inserts value at the provided index.
+// It panics if index is out of range.
+//
+// Time O(n/k + k) and space O(1), where k is the node capacity.
+func (l *UnrolledList[T]) Insert(value T, index int) {
+	if index < 0 || index > l.len {
+		panic(fmt.Sprintf("index out of range [%d] with length %d", index, l.len))
+	}
+
+	if l.head == nil {
+		l.head = newUnrolledNode[T]()
+		l.tail = l.head
+	}
+
+	_, n, offset := l.locate(index)
+
+	if len(n.values) == unrolledNodeCap {
+		if index == l.len {
+			// appending to a full tail starts a new node,
+			// so sequential appends leave nodes full
+			newNode := newUnrolledNode[T]()
+			n.next = newNode
+			l.tail = newNode
+			n, offset = newNode, 0
+		} else {
+			newNode := l.split(n)
+			if offset > len(n.values) {
+				n, offset = newNode, offset-len(n.values)
+			}
+		}
+	}
+
+	n.values = slices.Insert(n.values, offset, value)
+	l.len++
+}
+
+// Delete removes value at provided index.
+// It panics if index is out of bounds.
+//
+// Time O(n/k + k) and space O(1), where k is the node capacity.
+func (l *UnrolledList[T]) Delete(index int) {
+	if index < 0 || index >= l.len {
+		panic(fmt.Sprintf("index out of range [%d] with length %d", index, l.len))
+	}
+
+	prev, n, offset := l.locate(index)
+	n.values = slices.Delete(n.values, offset, offset+1)
+	l.len--
+
+	if next := n.next; next != nil && len(n.values) < unrolledNodeCap/2 {
+		if len(n.values)+len(next.values) <= unrolledNodeCap {
+			// merge
+			n.values = append(n.values, next.values...)
+			n.next = next.next
+			if l.tail == next {
+				l.tail = n
+			}
+		} else {
+			// refill
+			n.values = append(n.values, next.values[0])
+			next.values = slices.Delete(next.values, 0, 1)
+		}
+	}
+
+	// only the tail node can be left empty
+	if len(n.values) == 0 {
+		if prev == nil {
+			l.head = nil
+		} else {
+			prev.next = nil
+		}
+		l.tail = prev
+	}
+}
+
+// Len returns UnrolledList's length.
+func (l *UnrolledList[T]) Len() int {
+	return l.len
+}
+
+func (l *UnrolledList[T]) String() string {
+	var builder strings.Builder
+
+	// assume each element requires at least one byte for printing
+	// and one byte for spacing between elements
+	// len("UnrolledList[]") + (UnrolledList.len * 2)
+	builder.Grow(14 + (l.len * 2))
+
+	builder.WriteString("UnrolledList[")
+
+	for i, v := range l.All() {
+		if i != 0 {
+			builder.WriteString(" ")
+		}
+		builder.WriteString(fmt.Sprint(v))
+	}
+
+	builder.WriteString("]")
+
+	return builder.String()
+}
+
+// All returns an iterator over UnrolledList's index-value pairs.
+func (l *UnrolledList[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for n := l.head; n != nil; n = n.next {
+			for _, v := range n.values {
+				if !yield(i, v) {
+					return
+				}
+				i++
+			}
+		}
+	}
+}
+
+// Values returns an iterator over UnrolledList's elements.
+func (l *UnrolledList[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, value := range l.All() {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// locate returns the node that holds index, its predecessor and the
+// offset of index inside it. Index l.len maps to the end of the tail.
+func (l *UnrolledList[T]) locate(index int) (prev, n *unrolledNode[T], offset int) {
+	if index == l.len {
+		return nil, l.tail, len(l.tail.values)
+	}
+
+	for n = l.head; index >= len(n.values); n = n.next {
+		index -= len(n.values)
+		prev = n
+	}
+
+	return prev, n, index
+}
+
+// split moves the upper half of n's values to a new node
+// linked after n and returns it.
+func (l *UnrolledList[T]) split(n *unrolledNode[T]) *unrolledNode[T] {
+	half := len(n.values) / 2
+
+	newNode := newUnrolledNode[T]()
+	newNode.values = append(newNode.values, n.values[half:]...)
+	newNode.next = n.next
+
+	clear(n.values[half:])
+	n.values = n.values[:half]
+	n.next = newNode
+
+	if l.tail == n {
+		l.tail = newNode
+	}
+
+	return newNode
+}
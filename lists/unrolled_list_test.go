@@ -0,0 +1,202 @@
+package lists
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"dsa/arrays"
+)
+
+// checkUnrolled reports whether l has no empty or overflowing nodes,
+// a correct tail and length, and holds want.
+func checkUnrolled[T comparable](l *UnrolledList[T], want []T) bool {
+	var (
+		values []T
+		last   *unrolledNode[T]
+	)
+
+	for n := l.head; n != nil; n = n.next {
+		if len(n.values) == 0 || len(n.values) > unrolledNodeCap {
+			return false
+		}
+		values = append(values, n.values...)
+		last = n
+	}
+
+	return l.tail == last && l.len == len(want) && slices.Equal(values, want)
+}
+
+func TestUnrolledListOutOfBounds(t *testing.T) {
+	l := &UnrolledList[string]{}
+	l.Insert("a", 0)
+
+	for _, index := range []int{-1, 1} {
+		if !panics(func() { l.Read(index) }) {
+			t.Errorf("%v.Read(%d) expected to panic", l, index)
+		}
+		if !panics(func() { l.Delete(index) }) {
+			t.Errorf("%v.Delete(%d) expected to panic", l, index)
+		}
+	}
+
+	for _, index := range []int{-1, 2} {
+		if !panics(func() { l.Insert("", index) }) {
+			t.Errorf("%v.Insert(%d) expected to panic", l, index)
+		}
+	}
+}
+
+func TestUnrolledList(t *testing.T) {
+	l := &UnrolledList[string]{}
+	for i, v := range []string{"a", "c", "d"} {
+		l.Insert(v, i)
+	}
+	l.Insert("b", 1)
+
+	if !checkUnrolled(l, []string{"a", "b", "c", "d"}) {
+		t.Errorf("Insert() = %v, want UnrolledList[a b c d]", l)
+	}
+	if got, want := l.String(), "UnrolledList[a b c d]"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+	if got := l.Read(2); got != "c" {
+		t.Errorf("Read(2) = %q, want \"c\"", got)
+	}
+	if got := l.Search("d"); got != 3 {
+		t.Errorf("Search(\"d\") = %d, want 3", got)
+	}
+	if got := l.Search("e"); got != -1 {
+		t.Errorf("Search(\"e\") = %d, want -1", got)
+	}
+
+	for l.Len() > 0 {
+		l.Delete(0)
+	}
+	if !checkUnrolled(l, nil) || l.head != nil {
+		t.Errorf("%v is not empty", l)
+	}
+}
+
+// TestUnrolledListRandom compares UnrolledList with a slice through
+// random operations that split and merge nodes.
+func TestUnrolledListRandom(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	l := &UnrolledList[int]{}
+	var want []int
+
+	for i := range 20000 {
+		// grow for the first half, then shrink
+		if len(want) > 0 && r.IntN(10) < 3+4*(i/10000) {
+			index := r.IntN(len(want))
+			l.Delete(index)
+			want = slices.Delete(want, index, index+1)
+		} else {
+			index := r.IntN(len(want) + 1)
+			l.Insert(i, index)
+			want = slices.Insert(want, index, i)
+		}
+
+		if i%100 == 0 && !checkUnrolled(l, want) {
+			t.Fatalf("%d: list = %v, want %v", i, l, want)
+		}
+	}
+
+	if !checkUnrolled(l, want) {
+		t.Fatalf("list = %v, want %v", l, want)
+	}
+	for i, v := range want {
+		if got := l.Read(i); got != v {
+			t.Fatalf("Read(%d) = %d, want %d", i, got, v)
+		}
+	}
+}
+
+func BenchmarkUnrolledList(b *testing.B) {
+	const length = 100000
+
+	b.Run(fmt.Sprintf("append size = %d", length), func(b *testing.B) {
+		b.Run("UnrolledList", func(b *testing.B) {
+			b.ReportAllocs()
+			for range b.N {
+				l := &UnrolledList[int]{}
+				for i := range length {
+					l.Insert(i, i)
+				}
+			}
+		})
+
+		b.Run("LinkedList", func(b *testing.B) {
+			b.ReportAllocs()
+			for range b.N {
+				l := &LinkedList[int]{}
+				for i := range length {
+					l.Insert(i, i)
+				}
+			}
+		})
+
+		b.Run("Array", func(b *testing.B) {
+			b.ReportAllocs()
+			for range b.N {
+				var a arrays.Array[int]
+				for i := range length {
+					a.Insert(i, i)
+				}
+			}
+		})
+	})
+
+	b.Run(fmt.Sprintf("iterate size = %d", length), func(b *testing.B) {
+		unrolled, linked := &UnrolledList[int]{}, &LinkedList[int]{}
+		for i := range length {
+			unrolled.Insert(i, i)
+			linked.Append(i)
+		}
+
+		b.Run("UnrolledList", func(b *testing.B) {
+			for range b.N {
+				unrolled.Search(-1)
+			}
+		})
+
+		b.Run("LinkedList", func(b *testing.B) {
+			for range b.N {
+				linked.Search(-1)
+			}
+		})
+	})
+
+	b.Run(fmt.Sprintf("insert middle size = %d", length), func(b *testing.B) {
+		unrolled, linked := &UnrolledList[int]{}, &LinkedList[int]{}
+		var array arrays.Array[int]
+		for i := range length {
+			unrolled.Insert(i, i)
+			linked.Append(i)
+			array.Insert(i, i)
+		}
+
+		b.Run("UnrolledList", func(b *testing.B) {
+			for i := range b.N {
+				unrolled.Insert(i, unrolled.Len()/2)
+				unrolled.Delete(unrolled.Len() / 2)
+			}
+		})
+
+		b.Run("LinkedList", func(b *testing.B) {
+			for i := range b.N {
+				linked.Insert(i, linked.Len()/2)
+				linked.Delete(linked.Len() / 2)
+			}
+		})
+
+		b.Run("Array", func(b *testing.B) {
+			for i := range b.N {
+				array.Insert(i, len(array)/2)
+				// Array.Delete removes by value, so delete by index directly
+				array = slices.Delete(array, len(array)/2, len(array)/2+1)
+			}
+		})
+	})
+}
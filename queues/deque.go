@@ -0,0 +1,285 @@
+package queues
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+
+	"dsa/stacks"
+)
+
+var (
+	_ stacks.Stack[int] = &DequeStack[int]{}
+	_ Queue[int]        = &DequeQueue[int]{}
+)
+
+// blockSize is the number of elements stored by each Deque block.
+const blockSize = 64
+
+// Deque is a double-ended queue implemented as a segmented ring buffer.
+// Elements are stored in fixed-size blocks and only the ring of block
+// references is copied on growth, never the elements themselves.
+//
+// The zero value is an empty Deque ready to use.
+type Deque[T any] struct {
+	blocks [][]T
+	// first is the index in blocks of the block that holds the front element.
+	first int
+	// offset is the index of the front element inside blocks[first].
+	offset int
+	len    int
+}
+
+// PushFront adds value to the front of Deque.
+//
+// Time O(1) amortized and space O(1).
+func (d *Deque[T]) PushFront(value T) {
+	if d.offset == 0 {
+		if d.usedBlocks() == len(d.blocks) {
+			d.grow()
+		}
+
+		d.first = (d.first - 1 + len(d.blocks)) % len(d.blocks)
+		d.offset = blockSize
+	}
+
+	d.offset--
+	d.len++
+	*d.slot(0) = value
+}
+
+// PushBack adds value to the back of Deque.
+//
+// Time O(1) amortized and space O(1).
+func (d *Deque[T]) PushBack(value T) {
+	if (d.offset+d.len)/blockSize >= len(d.blocks) {
+		d.grow()
+	}
+
+	d.len++
+	*d.slot(d.len - 1) = value
+}
+
+// PopFront attempts to remove and return the value at the front
+// of Deque and reports whether it succeeded.
+//
+// Time O(1) and space O(1).
+func (d *Deque[T]) PopFront() (T, bool) {
+	var zero T
+	if d.len == 0 {
+		return zero, false
+	}
+
+	slot := d.slot(0)
+	value := *slot
+	*slot = zero
+
+	d.len--
+	d.offset++
+	if d.offset == blockSize {
+		d.offset = 0
+		d.first = (d.first + 1) % len(d.blocks)
+	}
+
+	return value, true
+}
+
+// PopBack attempts to remove and return the value at the back
+// of Deque and reports whether it succeeded.
+//
+// Time O(1) and space O(1).
+func (d *Deque[T]) PopBack() (T, bool) {
+	var zero T
+	if d.len == 0 {
+		return zero, false
+	}
+
+	slot := d.slot(d.len - 1)
+	value := *slot
+	*slot = zero
+
+	d.len--
+
+	return value, true
+}
+
+// PeekFront attempts to return the value at the front of Deque
+// without removing it and reports whether it succeeded.
+//
+// Time O(1) and space O(1).
+func (d *Deque[T]) PeekFront() (T, bool) {
+	if d.len == 0 {
+		var v T
+		return v, false
+	}
+
+	return *d.slot(0), true
+}
+
+// PeekBack attempts to return the value at the back of Deque
+// without removing it and reports whether it succeeded.
+//
+// Time O(1) and space O(1).
+func (d *Deque[T]) PeekBack() (T, bool) {
+	if d.len == 0 {
+		var v T
+		return v, false
+	}
+
+	return *d.slot(d.len - 1), true
+}
+
+// At returns the value at the provided index, counting from the front.
+// It panics if index is out of bounds.
+//
+// Time O(1) and space O(1).
+func (d *Deque[T]) At(index int) T {
+	if index < 0 || index >= d.len {
+		panic(fmt.Sprintf("index out of range [%d] with length %d", index, d.len))
+	}
+
+	return *d.slot(index)
+}
+
+// Len returns Deque's length.
+func (d *Deque[T]) Len() int {
+	return d.len
+}
+
+// Values returns an iterator over Deque's elements from front to back.
+func (d *Deque[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < d.len; i++ {
+			if !yield(*d.slot(i)) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over Deque's elements from back to front.
+func (d *Deque[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := d.len - 1; i >= 0; i-- {
+			if !yield(*d.slot(i)) {
+				return
+			}
+		}
+	}
+}
+
+func (d *Deque[T]) String() string {
+	var builder strings.Builder
+
+	// assume each element requires at least one byte for printing
+	// and one byte for spacing between elements
+	// len("Deque[]") + (Deque.len * 2)
+	builder.Grow(7 + (d.len * 2))
+
+	builder.WriteString("Deque[")
+
+	for i := 0; i < d.len; i++ {
+		if i != 0 {
+			builder.WriteString(" ")
+		}
+		builder.WriteString(fmt.Sprint(*d.slot(i)))
+	}
+
+	builder.WriteString("]")
+
+	return builder.String()
+}
+
+// AsStack returns a [stacks.Stack] view of Deque where the back
+// of Deque is the top of the stack.
+func (d *Deque[T]) AsStack() *DequeStack[T] {
+	return &DequeStack[T]{deque: d}
+}
+
+// AsQueue returns a [Queue] view of Deque where values are
+// enqueued at the back and dequeued from the front.
+func (d *Deque[T]) AsQueue() *DequeQueue[T] {
+	return &DequeQueue[T]{deque: d}
+}
+
+// slot returns a pointer to the element at index, allocating
+// its block if needed. Index must be in range [0, d.len).
+func (d *Deque[T]) slot(index int) *T {
+	position := d.offset + index
+	i := (d.first + position/blockSize) % len(d.blocks)
+
+	if d.blocks[i] == nil {
+		d.blocks[i] = make([]T, blockSize)
+	}
+
+	return &d.blocks[i][position%blockSize]
+}
+
+// usedBlocks returns how many blocks are needed to hold Deque's elements.
+func (d *Deque[T]) usedBlocks() int {
+	return (d.offset + d.len + blockSize - 1) / blockSize
+}
+
+// grow doubles the ring of blocks, keeping blocks in order starting
+// from index 0. Only block references are copied.
+func (d *Deque[T]) grow() {
+	n := len(d.blocks)
+	blocks := make([][]T, max(1, n*2))
+
+	for i := range n {
+		blocks[i] = d.blocks[(d.first+i)%n]
+	}
+
+	d.blocks = blocks
+	d.first = 0
+}
+
+// DequeStack adapts a [Deque] to the [stacks.Stack] interface.
+type DequeStack[T any] struct {
+	deque *Deque[T]
+}
+
+// Push adds value to the back of the underlying Deque.
+func (s *DequeStack[T]) Push(value T) {
+	s.deque.PushBack(value)
+}
+
+// Pop removes and returns the value at the back of the underlying Deque.
+func (s *DequeStack[T]) Pop() (T, bool) {
+	return s.deque.PopBack()
+}
+
+// Peek returns the value at the back of the underlying Deque.
+func (s *DequeStack[T]) Peek() (T, bool) {
+	return s.deque.PeekBack()
+}
+
+// Len returns the underlying Deque's length.
+func (s *DequeStack[T]) Len() int {
+	return s.deque.Len()
+}
+
+// DequeQueue adapts a [Deque] to the [Queue] interface.
+type DequeQueue[T any] struct {
+	deque *Deque[T]
+}
+
+// Enqueue adds value to the back of the underlying Deque.
+func (q *DequeQueue[T]) Enqueue(value T) {
+	q.deque.PushBack(value)
+}
+
+// Dequeue removes and returns the value at the front of the underlying Deque.
+func (q *DequeQueue[T]) Dequeue() (T, bool) {
+	return q.deque.PopFront()
+}
+
+// Peek returns the value at the front of the underlying Deque.
+func (q *DequeQueue[T]) Peek() (T, bool) {
+	return q.deque.PeekFront()
+}
+
+// Len returns the underlying Deque's length.
+func (q *DequeQueue[T]) Len() int {
+	return q.deque.Len()
+}
@@ -0,0 +1,241 @@
+package queues
+
+import (
+	"math/rand/v2"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		if e := recover(); e != nil {
+			panicked = true
+		}
+	}()
+
+	fn()
+
+	return panicked
+}
+
+func TestDequePush(t *testing.T) {
+	tests := []struct {
+		front []int
+		back  []int
+		want  []int
+	}{
+		{
+			nil,
+			nil,
+			nil,
+		},
+		{
+			[]int{1},
+			nil,
+			[]int{1},
+		},
+		{
+			nil,
+			[]int{1},
+			[]int{1},
+		},
+		{
+			[]int{2, 1},
+			[]int{3, 4},
+			[]int{1, 2, 3, 4},
+		},
+		{
+			nil,
+			[]int{1, 2, 3},
+			[]int{1, 2, 3},
+		},
+		{
+			[]int{3, 2, 1},
+			nil,
+			[]int{1, 2, 3},
+		},
+	}
+
+	for i, test := range tests {
+		d := &Deque[int]{}
+
+		for _, v := range test.front {
+			d.PushFront(v)
+		}
+		for _, v := range test.back {
+			d.PushBack(v)
+		}
+
+		if got := slices.Collect(d.Values()); !reflect.DeepEqual(got, test.want) || d.Len() != len(test.want) {
+			t.Errorf("%d: PushFront(%v) and PushBack(%v) = %v, want %v", i, test.front, test.back, d, test.want)
+		}
+	}
+}
+
+func TestDequePop(t *testing.T) {
+	d := &Deque[int]{}
+
+	if v, ok := d.PopFront(); v != 0 || ok {
+		t.Errorf("%v.PopFront() = (%v, %v), want (0, false)", d, v, ok)
+	}
+	if v, ok := d.PopBack(); v != 0 || ok {
+		t.Errorf("%v.PopBack() = (%v, %v), want (0, false)", d, v, ok)
+	}
+	if v, ok := d.PeekFront(); v != 0 || ok {
+		t.Errorf("%v.PeekFront() = (%v, %v), want (0, false)", d, v, ok)
+	}
+	if v, ok := d.PeekBack(); v != 0 || ok {
+		t.Errorf("%v.PeekBack() = (%v, %v), want (0, false)", d, v, ok)
+	}
+
+	for _, v := range []int{1, 2, 3} {
+		d.PushBack(v)
+	}
+
+	if v, ok := d.PeekFront(); v != 1 || !ok {
+		t.Errorf("%v.PeekFront() = (%v, %v), want (1, true)", d, v, ok)
+	}
+	if v, ok := d.PeekBack(); v != 3 || !ok {
+		t.Errorf("%v.PeekBack() = (%v, %v), want (3, true)", d, v, ok)
+	}
+	if v, ok := d.PopFront(); v != 1 || !ok {
+		t.Errorf("%v.PopFront() = (%v, %v), want (1, true)", d, v, ok)
+	}
+	if v, ok := d.PopBack(); v != 3 || !ok {
+		t.Errorf("%v.PopBack() = (%v, %v), want (3, true)", d, v, ok)
+	}
+	if got := d.String(); got != "Deque[2]" {
+		t.Errorf("%v.String() = %q, want %q", d, got, "Deque[2]")
+	}
+}
+
+func TestDequeAt(t *testing.T) {
+	d := &Deque[int]{}
+	for i := range 3 * blockSize {
+		d.PushBack(i)
+	}
+
+	for i := range d.Len() {
+		if got := d.At(i); got != i {
+			t.Errorf("At(%d) = %d, want %d", i, got, i)
+		}
+	}
+
+	for _, index := range []int{-1, d.Len()} {
+		if !panics(func() { d.At(index) }) {
+			t.Errorf("At(%d) expected to panic", index)
+		}
+	}
+}
+
+func TestDequeBackward(t *testing.T) {
+	d := &Deque[string]{}
+	for _, v := range []string{"b", "a"} {
+		d.PushFront(v)
+	}
+	d.PushBack("c")
+
+	if got, want := slices.Collect(d.Backward()), []string{"c", "b", "a"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("%v.Backward() = %v, want %v", d, got, want)
+	}
+}
+
+// TestDequeRandom compares Deque with a slice through random operations
+// that cross block boundaries in both directions.
+func TestDequeRandom(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	d := &Deque[int]{}
+	var want []int
+
+	for i := range 20000 {
+		switch r.IntN(4) {
+		case 0:
+			d.PushFront(i)
+			want = slices.Insert(want, 0, i)
+		case 1:
+			d.PushBack(i)
+			want = append(want, i)
+		case 2:
+			v, ok := d.PopFront()
+			if ok != (len(want) > 0) || ok && v != want[0] {
+				t.Fatalf("%d: PopFront() = (%v, %v)", i, v, ok)
+			}
+			if ok {
+				want = want[1:]
+			}
+		case 3:
+			v, ok := d.PopBack()
+			if ok != (len(want) > 0) || ok && v != want[len(want)-1] {
+				t.Fatalf("%d: PopBack() = (%v, %v)", i, v, ok)
+			}
+			if ok {
+				want = want[:len(want)-1]
+			}
+		}
+
+		if d.Len() != len(want) {
+			t.Fatalf("%d: Len() = %d, want %d", i, d.Len(), len(want))
+		}
+	}
+
+	if got := slices.Collect(d.Values()); !slices.Equal(got, want) {
+		t.Errorf("Values() = %v, want %v", got, want)
+	}
+}
+
+func TestDequeAdapters(t *testing.T) {
+	d := &Deque[int]{}
+
+	s := d.AsStack()
+	s.Push(1)
+	s.Push(2)
+	if v, ok := s.Peek(); v != 2 || !ok {
+		t.Errorf("AsStack().Peek() = (%v, %v), want (2, true)", v, ok)
+	}
+	if v, ok := s.Pop(); v != 2 || !ok || s.Len() != 1 {
+		t.Errorf("AsStack().Pop() = (%v, %v), want (2, true)", v, ok)
+	}
+
+	q := d.AsQueue()
+	q.Enqueue(3)
+	if v, ok := q.Peek(); v != 1 || !ok {
+		t.Errorf("AsQueue().Peek() = (%v, %v), want (1, true)", v, ok)
+	}
+	if v, ok := q.Dequeue(); v != 1 || !ok || q.Len() != 1 {
+		t.Errorf("AsQueue().Dequeue() = (%v, %v), want (1, true)", v, ok)
+	}
+	if got := d.String(); got != "Deque[3]" {
+		t.Errorf("String() = %q, want %q", got, "Deque[3]")
+	}
+}
+
+func BenchmarkDeque(b *testing.B) {
+	const length = 100000
+
+	b.Run("PushBack PopFront", func(b *testing.B) {
+		b.ReportAllocs()
+		for range b.N {
+			d := &Deque[int]{}
+			for i := range length {
+				d.PushBack(i)
+			}
+			for range length {
+				d.PopFront()
+			}
+		}
+	})
+
+	b.Run("PushFront PopBack", func(b *testing.B) {
+		b.ReportAllocs()
+		for range b.N {
+			d := &Deque[int]{}
+			for i := range length {
+				d.PushFront(i)
+			}
+			for range length {
+				d.PopBack()
+			}
+		}
+	})
+}
@@ -0,0 +1,209 @@
+package queues
+
+import "cmp"
+
+// Item is a handle to a value stored in a [PriorityQueue].
+// It is returned by [PriorityQueue.Push] and can later be used
+// to update the value's priority or remove it from the queue.
+type Item[T any, P cmp.Ordered] struct {
+	value    T
+	priority P
+	// index is the Item's position in the heap or -1 once removed.
+	index int
+	// seq is the insertion order, used to break ties in stable queues.
+	seq uint64
+}
+
+// Value returns Item's value.
+func (i *Item[T, P]) Value() T {
+	return i.value
+}
+
+// Priority returns Item's current priority.
+func (i *Item[T, P]) Priority() P {
+	return i.priority
+}
+
+// PriorityQueue is a min-priority queue implemented as a binary heap,
+// values with the lowest priority are removed first.
+//
+// The zero value is an empty PriorityQueue ready to use, where ties
+// between equal priorities are broken in no particular order.
+// Use [NewStablePriorityQueue] to break ties by insertion order.
+type PriorityQueue[T any, P cmp.Ordered] struct {
+	heap   []*Item[T, P]
+	seq    uint64
+	stable bool
+}
+
+// NewStablePriorityQueue returns an empty PriorityQueue that removes
+// values with equal priorities in insertion order, FIFO.
+func NewStablePriorityQueue[T any, P cmp.Ordered]() *PriorityQueue[T, P] {
+	return &PriorityQueue[T, P]{stable: true}
+}
+
+// Push adds value with the provided priority to PriorityQueue
+// and returns a handle to it.
+//
+// Time O(log(n)) and space O(1).
+func (q *PriorityQueue[T, P]) Push(value T, priority P) *Item[T, P] {
+	item := &Item[T, P]{
+		value:    value,
+		priority: priority,
+		index:    len(q.heap),
+		seq:      q.seq,
+	}
+	q.seq++
+
+	q.heap = append(q.heap, item)
+	q.up(item.index)
+
+	return item
+}
+
+// Pop attempts to remove and return the value with the lowest priority
+// and reports whether it succeeded.
+//
+// Time O(log(n)) and space O(1).
+func (q *PriorityQueue[T, P]) Pop() (T, P, bool) {
+	if len(q.heap) == 0 {
+		var (
+			v T
+			p P
+		)
+		return v, p, false
+	}
+
+	item := q.heap[0]
+	q.remove(0)
+
+	return item.value, item.priority, true
+}
+
+// Peek attempts to return the value with the lowest priority without
+// removing it and reports whether it succeeded.
+//
+// Time O(1) and space O(1).
+func (q *PriorityQueue[T, P]) Peek() (T, P, bool) {
+	if len(q.heap) == 0 {
+		var (
+			v T
+			p P
+		)
+		return v, p, false
+	}
+
+	return q.heap[0].value, q.heap[0].priority, true
+}
+
+// Update changes item's priority and reports whether item was found.
+// In stable queues, item keeps its original insertion order.
+//
+// Time O(log(n)) and space O(1).
+func (q *PriorityQueue[T, P]) Update(item *Item[T, P], priority P) bool {
+	if !q.contains(item) {
+		return false
+	}
+
+	item.priority = priority
+	if !q.up(item.index) {
+		q.down(item.index)
+	}
+
+	return true
+}
+
+// Remove removes item from PriorityQueue and reports whether it was found.
+//
+// Time O(log(n)) and space O(1).
+func (q *PriorityQueue[T, P]) Remove(item *Item[T, P]) bool {
+	if !q.contains(item) {
+		return false
+	}
+
+	q.remove(item.index)
+
+	return true
+}
+
+// Len returns PriorityQueue's length.
+func (q *PriorityQueue[T, P]) Len() int {
+	return len(q.heap)
+}
+
+// contains reports whether item belongs to PriorityQueue.
+func (q *PriorityQueue[T, P]) contains(item *Item[T, P]) bool {
+	return item != nil && item.index >= 0 && item.index < len(q.heap) && q.heap[item.index] == item
+}
+
+// remove removes the item at index i of the heap.
+func (q *PriorityQueue[T, P]) remove(i int) {
+	item := q.heap[i]
+	last := len(q.heap) - 1
+
+	q.swap(i, last)
+	q.heap[last] = nil
+	q.heap = q.heap[:last]
+	item.index = -1
+
+	if i < last && !q.up(i) {
+		q.down(i)
+	}
+}
+
+func (q *PriorityQueue[T, P]) less(i, j int) bool {
+	a, b := q.heap[i], q.heap[j]
+
+	if c := cmp.Compare(a.priority, b.priority); c != 0 {
+		return c < 0
+	}
+
+	return q.stable && a.seq < b.seq
+}
+
+func (q *PriorityQueue[T, P]) swap(i, j int) {
+	q.heap[i], q.heap[j] = q.heap[j], q.heap[i]
+	q.heap[i].index = i
+	q.heap[j].index = j
+}
+
+// up moves the item at index i towards the root until heap order
+// is restored and reports whether it moved.
+func (q *PriorityQueue[T, P]) up(i int) bool {
+	start := i
+
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !q.less(i, parent) {
+			break
+		}
+
+		q.swap(i, parent)
+		i = parent
+	}
+
+	return i != start
+}
+
+// down moves the item at index i towards the leaves until heap
+// order is restored.
+func (q *PriorityQueue[T, P]) down(i int) {
+	n := len(q.heap)
+
+	for {
+		smallest := i
+		if left := 2*i + 1; left < n && q.less(left, smallest) {
+			smallest = left
+		}
+		if right := 2*i + 2; right < n && q.less(right, smallest) {
+			smallest = right
+		}
+
+		if smallest == i {
+			return
+		}
+
+		q.swap(i, smallest)
+		i = smallest
+	}
+}
@@ -0,0 +1,165 @@
+package queues
+
+import (
+	"cmp"
+	"math/rand/v2"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func drain[T any, P cmp.Ordered](q *PriorityQueue[T, P]) []T {
+	var r []T
+
+	for {
+		v, _, ok := q.Pop()
+		if !ok {
+			return r
+		}
+		r = append(r, v)
+	}
+}
+
+func TestPriorityQueuePop(t *testing.T) {
+	q := &PriorityQueue[string, int]{}
+
+	if v, p, ok := q.Pop(); v != "" || p != 0 || ok {
+		t.Errorf("Pop() = (%q, %v, %v), want (\"\", 0, false)", v, p, ok)
+	}
+
+	q.Push("c", 3)
+	q.Push("a", 1)
+	q.Push("d", 4)
+	q.Push("b", 2)
+
+	if v, p, ok := q.Peek(); v != "a" || p != 1 || !ok {
+		t.Errorf("Peek() = (%q, %v, %v), want (\"a\", 1, true)", v, p, ok)
+	}
+
+	if got, want := drain(q), []string{"a", "b", "c", "d"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Pop() order = %v, want %v", got, want)
+	}
+}
+
+func TestPriorityQueueStable(t *testing.T) {
+	q := NewStablePriorityQueue[string, int]()
+
+	for _, v := range []string{"a", "b", "c", "d", "e"} {
+		q.Push(v, 1)
+	}
+	q.Push("first", 0)
+
+	if got, want := drain(q), []string{"first", "a", "b", "c", "d", "e"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Pop() order = %v, want %v", got, want)
+	}
+}
+
+func TestPriorityQueueUpdate(t *testing.T) {
+	q := &PriorityQueue[string, int]{}
+
+	a := q.Push("a", 1)
+	b := q.Push("b", 2)
+	c := q.Push("c", 3)
+
+	if !q.Update(c, 0) {
+		t.Errorf("Update(c, 0) = false, want true")
+	}
+	if !q.Update(a, 5) {
+		t.Errorf("Update(a, 5) = false, want true")
+	}
+	if a.Priority() != 5 || a.Value() != "a" {
+		t.Errorf("a = (%q, %v), want (\"a\", 5)", a.Value(), a.Priority())
+	}
+
+	if got, want := drain(q), []string{"c", "b", "a"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Pop() order = %v, want %v", got, want)
+	}
+
+	if q.Update(b, 1) {
+		t.Errorf("Update(b, 1) on popped item = true, want false")
+	}
+}
+
+func TestPriorityQueueRemove(t *testing.T) {
+	q := &PriorityQueue[string, int]{}
+	other := &PriorityQueue[string, int]{}
+
+	q.Push("a", 1)
+	b := q.Push("b", 2)
+	q.Push("c", 3)
+
+	if other.Remove(b) {
+		t.Errorf("other.Remove(b) = true, want false")
+	}
+	if !q.Remove(b) {
+		t.Errorf("Remove(b) = false, want true")
+	}
+	if q.Remove(b) {
+		t.Errorf("second Remove(b) = true, want false")
+	}
+	if q.Remove(nil) {
+		t.Errorf("Remove(nil) = true, want false")
+	}
+
+	if got, want := drain(q), []string{"a", "c"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Pop() order = %v, want %v", got, want)
+	}
+}
+
+// TestPriorityQueueRandom applies random pushes, updates and removals
+// and checks values are popped in priority order.
+func TestPriorityQueueRandom(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	q := NewStablePriorityQueue[int, int]()
+	items := map[int]*Item[int, int]{}
+
+	for i := range 2000 {
+		switch r.IntN(3) {
+		case 0, 1:
+			items[i] = q.Push(i, r.IntN(100))
+		case 2:
+			for k, item := range items {
+				if r.IntN(2) == 0 {
+					q.Remove(item)
+					delete(items, k)
+				} else {
+					q.Update(item, r.IntN(100))
+				}
+				break
+			}
+		}
+	}
+
+	if q.Len() != len(items) {
+		t.Fatalf("Len() = %d, want %d", q.Len(), len(items))
+	}
+
+	want := make([]*Item[int, int], 0, len(items))
+	for _, item := range items {
+		want = append(want, item)
+	}
+	slices.SortFunc(want, func(a, b *Item[int, int]) int {
+		return cmp.Or(cmp.Compare(a.priority, b.priority), cmp.Compare(a.seq, b.seq))
+	})
+
+	for i, item := range want {
+		if v, p, ok := q.Pop(); v != item.value || p != item.priority || !ok {
+			t.Fatalf("%d: Pop() = (%v, %v, %v), want (%v, %v, true)", i, v, p, ok, item.value, item.priority)
+		}
+	}
+}
+
+func BenchmarkPriorityQueue(b *testing.B) {
+	const length = 10000
+
+	b.ReportAllocs()
+	for range b.N {
+		q := &PriorityQueue[int, int]{}
+		for i := range length {
+			q.Push(i, (i*7919)%length)
+		}
+		for range length {
+			q.Pop()
+		}
+	}
+}
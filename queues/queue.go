@@ -0,0 +1,19 @@
+// Package queues defines the Queue interface and all of it's implementations.
+package queues
+
+// Queue is the interface for queues implementations.
+// A Queue is an abstract data type that is a collection of
+// elements where insertions and removals happen in FIFO,
+// first-in, first-out.
+type Queue[T any] interface {
+	// Enqueue adds a value to the back of the Queue.
+	Enqueue(T)
+	// Dequeue attempts to remove and return the value at the front
+	// of the Queue and reports whether it succeeded.
+	Dequeue() (T, bool)
+	// Peek attempts to return the value at the front of the Queue
+	// without removing it and reports whether it succeeded.
+	Peek() (T, bool)
+	// Len returns Queue's length.
+	Len() int
+}
@@ -0,0 +1,160 @@
+package sets
+
+// setOp is a set operation that builds a new Set.
+type setOp int
+
+const (
+	opUnion setOp = iota
+	opIntersection
+	opDifference
+	opSymmetricDifference
+)
+
+// merger is implemented by sets that can combine with sets of
+// their own type faster than through [Set.Has] lookups.
+type merger[T comparable] interface {
+	// merge returns the result of op over the receiver and other,
+	// and reports whether other's type is supported.
+	merge(other Set[T], op setOp) (Set[T], bool)
+}
+
+// Union returns a new Set with values that happen in a or b.
+//
+// Time O(n+m) expected and space O(n+m).
+func Union[T comparable](a, b Set[T]) Set[T] {
+	if r, ok := merge(a, b, opUnion); ok {
+		return r
+	}
+
+	r := NewHashSet[T](a.Len()+b.Len(), defaultLoadFactor)
+	for v := range a.Values() {
+		r.Add(v)
+	}
+	for v := range b.Values() {
+		r.Add(v)
+	}
+
+	return r
+}
+
+// Intersection returns a new Set with values that happen in both a and b.
+//
+// Time O(min(n, m)) lookups and space O(min(n, m)).
+func Intersection[T comparable](a, b Set[T]) Set[T] {
+	if r, ok := merge(a, b, opIntersection); ok {
+		return r
+	}
+
+	if a.Len() > b.Len() {
+		a, b = b, a
+	}
+
+	r := NewHashSet[T](a.Len(), defaultLoadFactor)
+	for v := range a.Values() {
+		if b.Has(v) {
+			r.Add(v)
+		}
+	}
+
+	return r
+}
+
+// Difference returns a new Set with values of a that do not happen in b.
+//
+// Time O(n) lookups and space O(n).
+func Difference[T comparable](a, b Set[T]) Set[T] {
+	if r, ok := merge(a, b, opDifference); ok {
+		return r
+	}
+
+	r := NewHashSet[T](a.Len(), defaultLoadFactor)
+	for v := range a.Values() {
+		if !b.Has(v) {
+			r.Add(v)
+		}
+	}
+
+	return r
+}
+
+// SymmetricDifference returns a new Set with values that happen
+// in either a or b, but not in both.
+//
+// Time O(n+m) lookups and space O(n+m).
+func SymmetricDifference[T comparable](a, b Set[T]) Set[T] {
+	if r, ok := merge(a, b, opSymmetricDifference); ok {
+		return r
+	}
+
+	r := NewHashSet[T](a.Len()+b.Len(), defaultLoadFactor)
+	for v := range a.Values() {
+		if !b.Has(v) {
+			r.Add(v)
+		}
+	}
+	for v := range b.Values() {
+		if !a.Has(v) {
+			r.Add(v)
+		}
+	}
+
+	return r
+}
+
+// IsSubset reports whether every value of a happens in b.
+//
+// Time O(n) lookups and space O(1).
+func IsSubset[T comparable](a, b Set[T]) bool {
+	if a.Len() > b.Len() {
+		return false
+	}
+
+	for v := range a.Values() {
+		if !b.Has(v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsSuperset reports whether every value of b happens in a.
+//
+// Time O(m) lookups and space O(1).
+func IsSuperset[T comparable](a, b Set[T]) bool {
+	return IsSubset(b, a)
+}
+
+// IsDisjoint reports whether a and b have no values in common.
+//
+// Time O(min(n, m)) lookups and space O(1).
+func IsDisjoint[T comparable](a, b Set[T]) bool {
+	if a.Len() > b.Len() {
+		a, b = b, a
+	}
+
+	for v := range a.Values() {
+		if b.Has(v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal reports whether a and b hold the same values.
+//
+// Time O(n) lookups and space O(1).
+func Equal[T comparable](a, b Set[T]) bool {
+	return a.Len() == b.Len() && IsSubset(a, b)
+}
+
+// merge applies op through a's fast path, if it has one for b's type.
+func merge[T comparable](a, b Set[T], op setOp) (Set[T], bool) {
+	m, ok := a.(merger[T])
+	if !ok {
+		return nil, false
+	}
+
+	return m.merge(b, op)
+}
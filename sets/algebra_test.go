@@ -0,0 +1,159 @@
+package sets
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+	"testing"
+)
+
+// kind builds a collection of one implementation holding the given
+// contents, so tests can run across every pair of implementations.
+type kind[C, V any] struct {
+	name  string
+	build func(contents V) C
+}
+
+// kindPairs returns an iterator over every ordered pair of kinds.
+func kindPairs[C, V any](kinds []kind[C, V]) iter.Seq2[kind[C, V], kind[C, V]] {
+	return func(yield func(kind[C, V], kind[C, V]) bool) {
+		for _, a := range kinds {
+			for _, b := range kinds {
+				if !yield(a, b) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// setKinds builds a Set of each implementation holding values.
+var setKinds = []kind[Set[int], []int]{
+	{"ArraySet", func(values []int) Set[int] { return fillSet(&ArraySet[int]{}, values) }},
+	{"OrderedArraySet", func(values []int) Set[int] { return fillSet(&OrderedArraySet[int]{}, values) }},
+	{"HashSet", func(values []int) Set[int] { return fillSet(&HashSet[int]{}, values) }},
+	{"TreeSet", func(values []int) Set[int] { return fillSet(&TreeSet[int]{}, values) }},
+}
+
+func fillSet(s Set[int], values []int) Set[int] {
+	for _, v := range values {
+		s.Add(v)
+	}
+
+	return s
+}
+
+func sorted(s Set[int]) []int {
+	values := slices.Sorted(s.Values())
+	if values == nil {
+		return []int{}
+	}
+
+	return values
+}
+
+func TestSetOperations(t *testing.T) {
+	operations := []struct {
+		name string
+		fn   func(a, b Set[int]) Set[int]
+	}{
+		{"Union", Union[int]},
+		{"Intersection", Intersection[int]},
+		{"Difference", Difference[int]},
+		{"SymmetricDifference", SymmetricDifference[int]},
+	}
+
+	tests := []struct {
+		a, b []int
+		// want holds one result per operation, in order
+		want [4][]int
+	}{
+		{
+			nil,
+			nil,
+			[4][]int{{}, {}, {}, {}},
+		},
+		{
+			[]int{1, 2},
+			nil,
+			[4][]int{{1, 2}, {}, {1, 2}, {1, 2}},
+		},
+		{
+			nil,
+			[]int{1, 2},
+			[4][]int{{1, 2}, {}, {}, {1, 2}},
+		},
+		{
+			[]int{1, 2, 3, 4},
+			[]int{3, 4, 5},
+			[4][]int{{1, 2, 3, 4, 5}, {3, 4}, {1, 2}, {1, 2, 5}},
+		},
+		{
+			[]int{5, 1, 3},
+			[]int{0, 2, 4, 6},
+			[4][]int{{0, 1, 2, 3, 4, 5, 6}, {}, {1, 3, 5}, {0, 1, 2, 3, 4, 5, 6}},
+		},
+	}
+
+	for kindA, kindB := range kindPairs(setKinds) {
+		for i, test := range tests {
+			for k, op := range operations {
+				a, b := kindA.build(test.a), kindB.build(test.b)
+				got := op.fn(a, b)
+
+				if !slices.Equal(sorted(got), test.want[k]) {
+					t.Errorf("%d: %s(%s%v, %s%v) = %v, want %v",
+						i, op.name, kindA.name, test.a, kindB.name, test.b, sorted(got), test.want[k])
+				}
+
+				if kindA.name == "OrderedArraySet" && kindB.name == "OrderedArraySet" {
+					if _, ok := got.(*OrderedArraySet[int]); !ok {
+						t.Errorf("%d: %s of OrderedArraySets returned %T", i, op.name, got)
+					}
+				}
+
+				if a.Len() != len(test.a) || b.Len() != len(test.b) {
+					t.Errorf("%d: %s modified its operands", i, op.name)
+				}
+			}
+		}
+	}
+}
+
+func TestSetPredicates(t *testing.T) {
+	tests := []struct {
+		a, b         []int
+		wantSubset   bool
+		wantSuperset bool
+		wantDisjoint bool
+		wantEqual    bool
+	}{
+		{nil, nil, true, true, true, true},
+		{nil, []int{1}, true, false, true, false},
+		{[]int{1}, nil, false, true, true, false},
+		{[]int{1, 2}, []int{2, 1}, true, true, false, true},
+		{[]int{1, 2}, []int{1, 2, 3}, true, false, false, false},
+		{[]int{1, 4}, []int{1, 2, 3}, false, false, false, false},
+		{[]int{4, 5}, []int{1, 2, 3}, false, false, true, false},
+	}
+
+	for kindA, kindB := range kindPairs(setKinds) {
+		for i, test := range tests {
+			a, b := kindA.build(test.a), kindB.build(test.b)
+			desc := fmt.Sprintf("%d: (%s%v, %s%v)", i, kindA.name, test.a, kindB.name, test.b)
+
+			if got := IsSubset(a, b); got != test.wantSubset {
+				t.Errorf("%s IsSubset = %v, want %v", desc, got, test.wantSubset)
+			}
+			if got := IsSuperset(a, b); got != test.wantSuperset {
+				t.Errorf("%s IsSuperset = %v, want %v", desc, got, test.wantSuperset)
+			}
+			if got := IsDisjoint(a, b); got != test.wantDisjoint {
+				t.Errorf("%s IsDisjoint = %v, want %v", desc, got, test.wantDisjoint)
+			}
+			if got := Equal(a, b); got != test.wantEqual {
+				t.Errorf("%s Equal = %v, want %v", desc, got, test.wantEqual)
+			}
+		}
+	}
+}
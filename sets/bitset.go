@@ -0,0 +1,240 @@
+package sets
+
+import (
+	"iter"
+	"math/bits"
+)
+
+var _ Set[uint] = &BitSet{}
+
+// wordBits is the number of bits in each BitSet word.
+const wordBits = 64
+
+// BitSet is a [Set] implementation for non-negative integers that uses
+// an array of 64-bit words underneath, where bit i tells whether i
+// happens in BitSet. It is compact for dense sets of small integers,
+// taking one bit for each integer up to the highest value.
+//
+// The zero value is an empty BitSet ready to use.
+type BitSet struct {
+	words []uint64
+}
+
+// NewBitSet returns an empty BitSet that holds values
+// lower than capacity without resizing.
+func NewBitSet(capacity uint) *BitSet {
+	return &BitSet{words: make([]uint64, 0, (capacity+wordBits-1)/wordBits)}
+}
+
+// Has reports whether value happens in BitSet.
+//
+// Time O(1) and space O(1).
+func (s *BitSet) Has(value uint) bool {
+	w := value / wordBits
+
+	return w < uint(len(s.words)) && s.words[w]&(1<<(value%wordBits)) != 0
+}
+
+// Add adds value to BitSet and reports whether it succeed.
+//
+// Time O(1) amortized and space O(1), or O(value) if BitSet grows.
+func (s *BitSet) Add(value uint) bool {
+	if s.Has(value) {
+		return false
+	}
+
+	s.grow(value/wordBits + 1)
+	s.words[value/wordBits] |= 1 << (value % wordBits)
+
+	return true
+}
+
+// Remove removes value from BitSet and reports whether it was found.
+//
+// Time O(1) amortized and space O(1).
+func (s *BitSet) Remove(value uint) bool {
+	if !s.Has(value) {
+		return false
+	}
+
+	s.words[value/wordBits] &^= 1 << (value % wordBits)
+	s.trim()
+
+	return true
+}
+
+// Len returns BitSet's length, counting the set bits of every word.
+//
+// Time O(n/64) and space O(1), where n is the highest value.
+func (s *BitSet) Len() int {
+	n := 0
+	for _, w := range s.words {
+		n += bits.OnesCount64(w)
+	}
+
+	return n
+}
+
+// Values returns an iterator of BitSet elements in ascending order.
+func (s *BitSet) Values() iter.Seq[uint] {
+	return func(yield func(uint) bool) {
+		for i, w := range s.words {
+			for w != 0 {
+				if !yield(uint(i)*wordBits + uint(bits.TrailingZeros64(w))) {
+					return
+				}
+				// clear the lowest set bit
+				w &= w - 1
+			}
+		}
+	}
+}
+
+// NextSet attempts to return the lowest value higher than or equal
+// to i and reports whether it succeeded.
+//
+// Time O(n/64) and space O(1), where n is the highest value.
+func (s *BitSet) NextSet(i uint) (uint, bool) {
+	w := i / wordBits
+	if w >= uint(len(s.words)) {
+		return 0, false
+	}
+
+	// ignore bits lower than i in its word
+	if word := s.words[w] >> (i % wordBits); word != 0 {
+		return i + uint(bits.TrailingZeros64(word)), true
+	}
+
+	for w++; w < uint(len(s.words)); w++ {
+		if s.words[w] != 0 {
+			return w*wordBits + uint(bits.TrailingZeros64(s.words[w])), true
+		}
+	}
+
+	return 0, false
+}
+
+// UnionWith adds every value of other to BitSet.
+//
+// Time O(m/64) and space O(1), where m is other's highest value.
+func (s *BitSet) UnionWith(other *BitSet) {
+	s.grow(uint(len(other.words)))
+
+	for i, w := range other.words {
+		s.words[i] |= w
+	}
+}
+
+// IntersectWith removes the values of BitSet that do not happen in other.
+//
+// Time O(n/64) and space O(1), where n is the highest value.
+func (s *BitSet) IntersectWith(other *BitSet) {
+	for i := range s.words {
+		if i < len(other.words) {
+			s.words[i] &= other.words[i]
+		} else {
+			s.words[i] = 0
+		}
+	}
+
+	s.trim()
+}
+
+// DifferenceWith removes every value of other from BitSet.
+//
+// Time O(min(n, m)/64) and space O(1), where n and m are
+// the highest values of BitSet and other.
+func (s *BitSet) DifferenceWith(other *BitSet) {
+	for i := range min(len(s.words), len(other.words)) {
+		s.words[i] &^= other.words[i]
+	}
+
+	s.trim()
+}
+
+// SymmetricDifferenceWith keeps the values that happen in either
+// BitSet or other, but not in both.
+//
+// Time O(m/64) and space O(1), where m is other's highest value.
+func (s *BitSet) SymmetricDifferenceWith(other *BitSet) {
+	s.grow(uint(len(other.words)))
+
+	for i, w := range other.words {
+		s.words[i] ^= w
+	}
+
+	s.trim()
+}
+
+// Complement flips every value in range [lo, hi), adding the ones
+// that do not happen in BitSet and removing the ones that do.
+//
+// Time O(hi/64) and space O(1), or O(hi/64) if BitSet grows.
+func (s *BitSet) Complement(lo, hi uint) {
+	if lo >= hi {
+		return
+	}
+
+	first, last := lo/wordBits, (hi-1)/wordBits
+	s.grow(last + 1)
+
+	for w := first; w <= last; w++ {
+		mask := ^uint64(0)
+		if w == first {
+			mask &= ^uint64(0) << (lo % wordBits)
+		}
+		if w == last {
+			mask &= ^uint64(0) >> (wordBits - 1 - (hi-1)%wordBits)
+		}
+		s.words[w] ^= mask
+	}
+
+	s.trim()
+}
+
+// Clone returns a copy of BitSet.
+//
+// Time O(n/64) and space O(n/64), where n is the highest value.
+func (s *BitSet) Clone() *BitSet {
+	return &BitSet{words: append([]uint64(nil), s.words...)}
+}
+
+// merge implements [merger] by combining two BitSets word by word.
+//
+// Time O(max(n, m)/64) and space O(max(n, m)/64).
+func (s *BitSet) merge(other Set[uint], op setOp) (Set[uint], bool) {
+	o, ok := other.(*BitSet)
+	if !ok {
+		return nil, false
+	}
+
+	r := s.Clone()
+
+	switch op {
+	case opUnion:
+		r.UnionWith(o)
+	case opIntersection:
+		r.IntersectWith(o)
+	case opDifference:
+		r.DifferenceWith(o)
+	case opSymmetricDifference:
+		r.SymmetricDifferenceWith(o)
+	}
+
+	return r, true
+}
+
+// grow extends BitSet to at least n words.
+func (s *BitSet) grow(n uint) {
+	if n > uint(len(s.words)) {
+		s.words = append(s.words, make([]uint64, n-uint(len(s.words)))...)
+	}
+}
+
+// trim drops trailing zero words, so that BitSet shrinks
+// after its highest values are removed.
+func (s *BitSet) trim() {
+	for len(s.words) > 0 && s.words[len(s.words)-1] == 0 {
+		s.words = s.words[:len(s.words)-1]
+	}
+}
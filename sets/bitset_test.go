@@ -0,0 +1,134 @@
+package sets
+
+import (
+	"slices"
+	"testing"
+)
+
+func bitSetOf(values ...uint) *BitSet {
+	s := &BitSet{}
+	for _, v := range values {
+		s.Add(v)
+	}
+
+	return s
+}
+
+func TestBitSet(t *testing.T) {
+	s := NewBitSet(64)
+
+	for _, v := range []uint{3, 0, 64, 200} {
+		if !s.Add(v) {
+			t.Errorf("Add(%d) = false, want true", v)
+		}
+	}
+	if s.Add(64) {
+		t.Errorf("Add(64) = true for a present value, want false")
+	}
+
+	for v, want := range map[uint]bool{0: true, 1: false, 3: true, 64: true, 63: false, 200: true, 10000: false} {
+		if got := s.Has(v); got != want {
+			t.Errorf("Has(%d) = %v, want %v", v, got, want)
+		}
+	}
+
+	if got := s.Len(); got != 4 {
+		t.Errorf("Len() = %d, want 4", got)
+	}
+	if got, want := slices.Collect(s.Values()), []uint{0, 3, 64, 200}; !slices.Equal(got, want) {
+		t.Errorf("Values() = %v, want %v", got, want)
+	}
+
+	if !s.Remove(200) || s.Remove(200) || s.Remove(10000) {
+		t.Errorf("Remove() reports wrong results")
+	}
+	if got := len(s.words); got != 2 {
+		t.Errorf("len(words) = %d after removing the highest value, want 2", got)
+	}
+}
+
+func TestBitSetNextSet(t *testing.T) {
+	s := bitSetOf(1, 63, 64, 300)
+
+	type result struct {
+		value uint
+		ok    bool
+	}
+
+	tests := []struct {
+		i    uint
+		want result
+	}{
+		{0, result{1, true}},
+		{1, result{1, true}},
+		{2, result{63, true}},
+		{64, result{64, true}},
+		{65, result{300, true}},
+		{301, result{0, false}},
+		{1000, result{0, false}},
+	}
+
+	for _, test := range tests {
+		if v, ok := s.NextSet(test.i); (result{v, ok}) != test.want {
+			t.Errorf("NextSet(%d) = (%d, %v), want %v", test.i, v, ok, test.want)
+		}
+	}
+}
+
+func TestBitSetWordOperations(t *testing.T) {
+	operations := []struct {
+		name string
+		fn   func(s, other *BitSet)
+		want []uint
+	}{
+		{"UnionWith", (*BitSet).UnionWith, []uint{1, 2, 3, 100, 130}},
+		{"IntersectWith", (*BitSet).IntersectWith, []uint{2}},
+		{"DifferenceWith", (*BitSet).DifferenceWith, []uint{1, 130}},
+		{"SymmetricDifferenceWith", (*BitSet).SymmetricDifferenceWith, []uint{1, 3, 100, 130}},
+	}
+
+	for _, op := range operations {
+		s, other := bitSetOf(1, 2, 130), bitSetOf(2, 3, 100)
+		op.fn(s, other)
+
+		if got := slices.Collect(s.Values()); !slices.Equal(got, op.want) {
+			t.Errorf("%s() = %v, want %v", op.name, got, op.want)
+		}
+		if got := slices.Collect(other.Values()); !slices.Equal(got, []uint{2, 3, 100}) {
+			t.Errorf("%s() modified its argument to %v", op.name, got)
+		}
+	}
+
+	if r, ok := Union[uint](bitSetOf(1), bitSetOf(2)).(*BitSet); !ok || r.Len() != 2 {
+		t.Errorf("Union of BitSets = %v, want a BitSet of length 2", r)
+	}
+}
+
+func TestBitSetComplement(t *testing.T) {
+	tests := []struct {
+		values []uint
+		lo, hi uint
+		want   []uint
+	}{
+		{nil, 0, 3, []uint{0, 1, 2}},
+		{[]uint{1, 5}, 0, 3, []uint{0, 2, 5}},
+		{[]uint{1, 5}, 3, 3, []uint{1, 5}},
+		{[]uint{63, 64}, 62, 66, []uint{62, 65}},
+		{[]uint{0, 1, 2}, 0, 3, nil},
+	}
+
+	for i, test := range tests {
+		s := bitSetOf(test.values...)
+		s.Complement(test.lo, test.hi)
+
+		if got := slices.Collect(s.Values()); !slices.Equal(got, test.want) {
+			t.Errorf("%d: %v.Complement(%d, %d) = %v, want %v", i, test.values, test.lo, test.hi, got, test.want)
+		}
+	}
+
+	s := &BitSet{}
+	s.Complement(10, 200)
+	if got := s.Len(); got != 190 {
+		t.Errorf("Len() = %d after Complement(10, 200), want 190", got)
+	}
+}
@@ -0,0 +1,163 @@
+package sets
+
+import "iter"
+
+// DisjointSet, also known as union-find, is a data structure that keeps
+// values partitioned into disjoint sets, merging them and finding which
+// set a value belongs to in nearly constant time.
+//
+// Each set is a tree whose root is the set representative. Find
+// compresses the paths it walks and Union links the root of the
+// smaller tree under the larger one, which keeps trees flat.
+//
+// The zero value is an empty DisjointSet ready to use.
+type DisjointSet[T comparable] struct {
+	// values are indexed in insertion order, so trees link indexes
+	ids    map[T]int
+	values []T
+	parent []int
+	// size[i] is the size of the tree rooted at i, if it is a root
+	size  []int
+	count int
+}
+
+// MakeSet adds value to DisjointSet in a set of its own
+// and reports whether it succeed.
+//
+// Time O(1) amortized and space O(1).
+func (d *DisjointSet[T]) MakeSet(value T) bool {
+	if _, ok := d.ids[value]; ok {
+		return false
+	}
+
+	if d.ids == nil {
+		d.ids = make(map[T]int)
+	}
+
+	d.ids[value] = len(d.values)
+	d.parent = append(d.parent, len(d.values))
+	d.size = append(d.size, 1)
+	d.values = append(d.values, value)
+	d.count++
+
+	return true
+}
+
+// Find attempts to return the representative of the set value belongs
+// to and reports whether value happens in DisjointSet. Values in the
+// same set have the same representative until sets change.
+//
+// Time O(α(n)) amortized and space O(1), where α is the inverse
+// Ackermann function, which is at most 4 for any practical n.
+func (d *DisjointSet[T]) Find(value T) (T, bool) {
+	id, ok := d.ids[value]
+	if !ok {
+		var v T
+		return v, false
+	}
+
+	return d.values[d.find(id)], true
+}
+
+// Union merges the sets a and b belong to and reports whether they
+// were different. Values not in DisjointSet are added first.
+//
+// Time O(α(n)) amortized and space O(1).
+func (d *DisjointSet[T]) Union(a, b T) bool {
+	d.MakeSet(a)
+	d.MakeSet(b)
+
+	rootA, rootB := d.find(d.ids[a]), d.find(d.ids[b])
+	if rootA == rootB {
+		return false
+	}
+
+	if d.size[rootA] < d.size[rootB] {
+		rootA, rootB = rootB, rootA
+	}
+
+	d.parent[rootB] = rootA
+	d.size[rootA] += d.size[rootB]
+	d.count--
+
+	return true
+}
+
+// Connected reports whether a and b happen in the same set.
+//
+// Time O(α(n)) amortized and space O(1).
+func (d *DisjointSet[T]) Connected(a, b T) bool {
+	idA, okA := d.ids[a]
+	idB, okB := d.ids[b]
+
+	return okA && okB && d.find(idA) == d.find(idB)
+}
+
+// SetSize returns the size of the set value belongs to,
+// or 0 if value does not happen in DisjointSet.
+//
+// Time O(α(n)) amortized and space O(1).
+func (d *DisjointSet[T]) SetSize(value T) int {
+	id, ok := d.ids[value]
+	if !ok {
+		return 0
+	}
+
+	return d.size[d.find(id)]
+}
+
+// Len returns DisjointSet's length, the number of values.
+func (d *DisjointSet[T]) Len() int {
+	return len(d.values)
+}
+
+// Count returns the number of disjoint sets.
+func (d *DisjointSet[T]) Count() int {
+	return d.count
+}
+
+// Groups returns an iterator over DisjointSet's sets, ordered by their
+// first added value, each holding its values in insertion order.
+//
+// Time O(n*α(n)) and space O(n).
+func (d *DisjointSet[T]) Groups() iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		// group[root] is the index in groups of root's set
+		group := make(map[int]int, d.count)
+		groups := make([][]T, 0, d.count)
+
+		for id, value := range d.values {
+			root := d.find(id)
+
+			g, ok := group[root]
+			if !ok {
+				g = len(groups)
+				group[root] = g
+				groups = append(groups, make([]T, 0, d.size[root]))
+			}
+
+			groups[g] = append(groups[g], value)
+		}
+
+		for _, g := range groups {
+			if !yield(g) {
+				return
+			}
+		}
+	}
+}
+
+// find returns the root of the tree holding id, linking every
+// node on the way directly to it.
+func (d *DisjointSet[T]) find(id int) int {
+	root := id
+	for d.parent[root] != root {
+		root = d.parent[root]
+	}
+
+	for d.parent[id] != root {
+		d.parent[id], id = root, d.parent[id]
+	}
+
+	return root
+}
@@ -0,0 +1,172 @@
+package sets
+
+import (
+	"math/rand/v2"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestDisjointSet(t *testing.T) {
+	d := &DisjointSet[string]{}
+
+	for _, v := range []string{"a", "b", "c", "d", "e"} {
+		if !d.MakeSet(v) {
+			t.Errorf("MakeSet(%q) = false, want true", v)
+		}
+	}
+	if d.MakeSet("a") {
+		t.Errorf("MakeSet(%q) = true for a present value, want false", "a")
+	}
+
+	unions := []struct {
+		a, b string
+		want bool
+	}{
+		{"a", "b", true},
+		{"c", "d", true},
+		{"b", "a", false},
+		{"b", "d", true},
+		{"a", "c", false},
+		{"f", "e", true},
+	}
+
+	for _, u := range unions {
+		if got := d.Union(u.a, u.b); got != u.want {
+			t.Errorf("Union(%q, %q) = %v, want %v", u.a, u.b, got, u.want)
+		}
+	}
+
+	connected := []struct {
+		a, b string
+		want bool
+	}{
+		{"a", "d", true},
+		{"e", "f", true},
+		{"a", "e", false},
+		{"a", "z", false},
+		{"z", "z", false},
+	}
+
+	for _, c := range connected {
+		if got := d.Connected(c.a, c.b); got != c.want {
+			t.Errorf("Connected(%q, %q) = %v, want %v", c.a, c.b, got, c.want)
+		}
+	}
+
+	for value, want := range map[string]int{"a": 4, "d": 4, "e": 2, "z": 0} {
+		if got := d.SetSize(value); got != want {
+			t.Errorf("SetSize(%q) = %d, want %d", value, got, want)
+		}
+	}
+
+	rootA, _ := d.Find("a")
+	if rootD, _ := d.Find("d"); rootA != rootD {
+		t.Errorf("Find(%q) = %q and Find(%q) = %q, want equal", "a", rootA, "d", rootD)
+	}
+	if v, ok := d.Find("z"); v != "" || ok {
+		t.Errorf("Find(%q) = (%q, %v), want (\"\", false)", "z", v, ok)
+	}
+
+	if d.Len() != 6 || d.Count() != 2 {
+		t.Errorf("(Len(), Count()) = (%d, %d), want (6, 2)", d.Len(), d.Count())
+	}
+
+	want := [][]string{{"a", "b", "c", "d"}, {"e", "f"}}
+	if got := slices.Collect(d.Groups()); !reflect.DeepEqual(got, want) {
+		t.Errorf("Groups() = %v, want %v", got, want)
+	}
+}
+
+func TestDisjointSetTrees(t *testing.T) {
+	d := &DisjointSet[int]{}
+
+	// a chain of unions of a singleton into a growing set
+	// links the singleton under the set root every time
+	for i := 1; i < 8; i++ {
+		d.Union(0, i)
+	}
+	for i := 1; i < 8; i++ {
+		if d.parent[i] != 0 {
+			t.Errorf("parent[%d] = %d, want 0", i, d.parent[i])
+		}
+	}
+
+	// merging a long path, built by hand, is flattened by find
+	d = &DisjointSet[int]{}
+	for i := range 5 {
+		d.MakeSet(i)
+	}
+	for i := 1; i < 5; i++ {
+		d.parent[i] = i - 1
+	}
+
+	d.Find(4)
+
+	for i := 1; i < 5; i++ {
+		if d.parent[i] != 0 {
+			t.Errorf("parent[%d] = %d after Find(4), want 0", i, d.parent[i])
+		}
+	}
+}
+
+func TestDisjointSetRandom(t *testing.T) {
+	const length = 200
+
+	rng := rand.New(rand.NewPCG(1, 2))
+
+	d := &DisjointSet[int]{}
+	// label[v] is v's set in a naive relabeling implementation
+	label := make([]int, length)
+	for v := range length {
+		d.MakeSet(v)
+		label[v] = v
+	}
+
+	for range 300 {
+		a, b := rng.IntN(length), rng.IntN(length)
+
+		if got, want := d.Union(a, b), label[a] != label[b]; got != want {
+			t.Fatalf("Union(%d, %d) = %v, want %v", a, b, got, want)
+		}
+
+		old := label[b]
+		for v := range label {
+			if label[v] == old {
+				label[v] = label[a]
+			}
+		}
+
+		c := rng.IntN(length)
+		if got, want := d.Connected(a, c), label[a] == label[c]; got != want {
+			t.Fatalf("Connected(%d, %d) = %v, want %v", a, c, got, want)
+		}
+		if got, want := d.SetSize(c), countLabel(label, label[c]); got != want {
+			t.Fatalf("SetSize(%d) = %d, want %d", c, got, want)
+		}
+	}
+
+	total := 0
+	for group := range d.Groups() {
+		for _, v := range group {
+			if label[v] != label[group[0]] {
+				t.Fatalf("group %v mixes sets", group)
+			}
+		}
+		total += len(group)
+	}
+	if total != length {
+		t.Errorf("Groups() hold %d values, want %d", total, length)
+	}
+}
+
+func countLabel(label []int, l int) int {
+	n := 0
+	for _, v := range label {
+		if v == l {
+			n++
+		}
+	}
+
+	return n
+}
@@ -0,0 +1,199 @@
+package sets
+
+import (
+	"fmt"
+	"hash/maphash"
+	"iter"
+	"math/bits"
+)
+
+var _ Set[int] = &HashSet[int]{}
+
+const (
+	// defaultLoadFactor is the load factor of zero value HashSets.
+	defaultLoadFactor = 0.75
+	// minHashSetCap is the minimum number of slots of a HashSet.
+	minHashSetCap = 8
+)
+
+type slotState uint8
+
+const (
+	empty slotState = iota
+	occupied
+	// deleted marks a removed value, a tombstone, so probe
+	// sequences going through it are not interrupted.
+	deleted
+)
+
+type hashSlot[T comparable] struct {
+	value T
+	state slotState
+}
+
+// HashSet is a [Set] implementation that uses a hash table with open
+// addressing and linear probing underneath.
+//
+// Removed values leave tombstones behind, which are compacted by
+// rehashing once they take too much of the table.
+//
+// The zero value is an empty HashSet ready to use, with a load
+// factor of 0.75.
+type HashSet[T comparable] struct {
+	slots      []hashSlot[T]
+	len        int
+	tombstones int
+	loadFactor float64
+	seed       maphash.Seed
+}
+
+// NewHashSet returns an empty HashSet that holds capacity values
+// without resizing and keeps the fraction of used slots, values and
+// tombstones, at most loadFactor.
+// It panics if loadFactor is not in range (0, 1).
+func NewHashSet[T comparable](capacity int, loadFactor float64) *HashSet[T] {
+	if loadFactor <= 0 || loadFactor >= 1 {
+		panic(fmt.Sprintf("load factor %v out of range (0, 1)", loadFactor))
+	}
+
+	s := &HashSet[T]{loadFactor: loadFactor}
+	s.rehash(s.capacityFor(capacity))
+
+	return s
+}
+
+// Has reports whether value happens in HashSet.
+//
+// Time O(1) expected and space O(1).
+func (s *HashSet[T]) Has(value T) bool {
+	if s.len == 0 {
+		return false
+	}
+
+	i, found := s.find(value)
+
+	return found && s.slots[i].state == occupied
+}
+
+// Add adds value to HashSet and reports whether it succeed.
+//
+// Time O(1) amortized expected and space O(1).
+func (s *HashSet[T]) Add(value T) bool {
+	if s.slots == nil {
+		if s.loadFactor == 0 {
+			s.loadFactor = defaultLoadFactor
+		}
+		s.rehash(minHashSetCap)
+	}
+
+	i, found := s.find(value)
+	if found {
+		return false
+	}
+
+	if s.slots[i].state == deleted {
+		s.tombstones--
+	} else if float64(s.len+s.tombstones+1) > s.loadFactor*float64(len(s.slots)) {
+		// only growing when values, not tombstones, fill the table
+		s.rehash(s.capacityFor(s.len + 1))
+		i, _ = s.find(value)
+	}
+
+	s.slots[i] = hashSlot[T]{value: value, state: occupied}
+	s.len++
+
+	return true
+}
+
+// Remove removes value from HashSet and reports whether it was found.
+//
+// Time O(1) amortized expected and space O(1).
+func (s *HashSet[T]) Remove(value T) bool {
+	if s.len == 0 {
+		return false
+	}
+
+	i, found := s.find(value)
+	if !found {
+		return false
+	}
+
+	s.slots[i] = hashSlot[T]{state: deleted}
+	s.len--
+	s.tombstones++
+
+	// compact once tombstones outnumber values, which also
+	// shrinks the table after most values were removed
+	if s.tombstones > s.len && len(s.slots) > minHashSetCap {
+		s.rehash(s.capacityFor(s.len))
+	}
+
+	return true
+}
+
+// Len returns HashSet's length.
+func (s *HashSet[T]) Len() int {
+	return s.len
+}
+
+// Values returns an iterator of HashSet elements.
+func (s *HashSet[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, slot := range s.slots {
+			if slot.state == occupied && !yield(slot.value) {
+				return
+			}
+		}
+	}
+}
+
+// find returns the slot index holding value and true, or false and the
+// index where value should be inserted: the first tombstone found
+// while probing or else the empty slot that ended the probe.
+func (s *HashSet[T]) find(value T) (int, bool) {
+	mask := len(s.slots) - 1
+	tombstone := -1
+
+	for i := int(maphash.Comparable(s.seed, value)) & mask; ; i = (i + 1) & mask {
+		switch slot := &s.slots[i]; slot.state {
+		case empty:
+			if tombstone != -1 {
+				return tombstone, false
+			}
+			return i, false
+		case deleted:
+			if tombstone == -1 {
+				tombstone = i
+			}
+		case occupied:
+			if slot.value == value {
+				return i, true
+			}
+		}
+	}
+}
+
+// capacityFor returns the number of slots, a power of two, needed to
+// hold n values within HashSet's load factor.
+func (s *HashSet[T]) capacityFor(n int) int {
+	slots := int(float64(n)/s.loadFactor) + 1
+
+	return max(minHashSetCap, 1<<bits.Len(uint(slots-1)))
+}
+
+// rehash moves every value into a new table with capacity slots,
+// dropping all tombstones.
+func (s *HashSet[T]) rehash(capacity int) {
+	old := s.slots
+
+	s.slots = make([]hashSlot[T], capacity)
+	s.tombstones = 0
+	s.seed = maphash.MakeSeed()
+
+	for _, slot := range old {
+		if slot.state == occupied {
+			i, _ := s.find(slot.value)
+			s.slots[i] = slot
+		}
+	}
+}
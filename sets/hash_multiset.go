@@ -0,0 +1,100 @@
+package sets
+
+import "iter"
+
+var _ Multiset[int] = &HashMultiset[int]{}
+
+// HashMultiset is a [Multiset] implementation that uses
+// a hash map from values to their counts underneath.
+//
+// The zero value is an empty HashMultiset ready to use.
+type HashMultiset[T comparable] struct {
+	counts map[T]int
+	len    int
+}
+
+// NewHashMultiset returns an empty HashMultiset that holds
+// capacity distinct values without resizing.
+func NewHashMultiset[T comparable](capacity int) *HashMultiset[T] {
+	return &HashMultiset[T]{counts: make(map[T]int, capacity)}
+}
+
+// Add adds n occurrences of value to HashMultiset and returns its new count.
+// It panics if n is negative.
+//
+// Time O(1) amortized expected and space O(1).
+func (m *HashMultiset[T]) Add(value T, n int) int {
+	checkCount(n)
+
+	if n == 0 {
+		return m.counts[value]
+	}
+
+	if m.counts == nil {
+		m.counts = make(map[T]int)
+	}
+
+	m.counts[value] += n
+	m.len += n
+
+	return m.counts[value]
+}
+
+// Remove removes up to n occurrences of value from HashMultiset
+// and returns how many were removed.
+// It panics if n is negative.
+//
+// Time O(1) expected and space O(1).
+func (m *HashMultiset[T]) Remove(value T, n int) int {
+	checkCount(n)
+
+	count := m.counts[value]
+	removed := min(n, count)
+
+	if removed == count {
+		delete(m.counts, value)
+	} else {
+		m.counts[value] -= removed
+	}
+	m.len -= removed
+
+	return removed
+}
+
+// Count returns the number of occurrences of value.
+//
+// Time O(1) expected and space O(1).
+func (m *HashMultiset[T]) Count(value T) int {
+	return m.counts[value]
+}
+
+// Len returns HashMultiset's length, counting every occurrence.
+func (m *HashMultiset[T]) Len() int {
+	return m.len
+}
+
+// Distinct returns the number of distinct values in HashMultiset.
+func (m *HashMultiset[T]) Distinct() int {
+	return len(m.counts)
+}
+
+// All returns an iterator over HashMultiset's value-count pairs.
+func (m *HashMultiset[T]) All() iter.Seq2[T, int] {
+	return func(yield func(T, int) bool) {
+		for v, c := range m.counts {
+			if !yield(v, c) {
+				return
+			}
+		}
+	}
+}
+
+// MostCommon returns the k values with the highest counts, or all
+// of them if there are fewer, in descending order of count.
+// Values with equal counts are in no particular order.
+// It panics if k is negative.
+//
+// Time O(d*log(d)) and space O(d), where d is the number of distinct values.
+func (m *HashMultiset[T]) MostCommon(k int) []ValueCount[T] {
+	return mostCommon(m.All(), len(m.counts), k)
+}
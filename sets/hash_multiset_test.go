@@ -0,0 +1,8 @@
+package sets
+
+import "testing"
+
+func TestHashMultiset(t *testing.T) {
+	testMultiset(t, func() Multiset[int] { return &HashMultiset[int]{} })
+	testMultiset(t, func() Multiset[int] { return NewHashMultiset[int](16) })
+}
@@ -0,0 +1,221 @@
+package sets
+
+import (
+	"fmt"
+	"iter"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestHashSetHas(t *testing.T) {
+	tests := []struct {
+		values []string
+		value  string
+		want   bool
+	}{
+		{nil, "a", false},
+		{[]string{"a"}, "a", true},
+		{[]string{"a", "b", "c"}, "c", true},
+		{[]string{"a", "b", "c"}, "d", false},
+	}
+
+	for i, test := range tests {
+		s := &HashSet[string]{}
+		for _, v := range test.values {
+			s.Add(v)
+		}
+
+		if got := s.Has(test.value); got != test.want {
+			t.Errorf("%d: %v.Has(%q) = %v, want %v", i, test.values, test.value, got, test.want)
+		}
+	}
+}
+
+func TestHashSetAddRemove(t *testing.T) {
+	s := &HashSet[string]{}
+
+	if !s.Add("a") || !s.Add("b") || s.Add("a") || s.Len() != 2 {
+		t.Errorf("Add(a, b, a) = %v, want [a b]", slices.Collect(s.Values()))
+	}
+
+	if s.Remove("c") || !s.Remove("a") || s.Remove("a") || s.Len() != 1 {
+		t.Errorf("Remove(c, a, a) = %v, want [b]", slices.Collect(s.Values()))
+	}
+
+	if !s.Add("a") || !s.Has("a") || !s.Has("b") || s.Len() != 2 {
+		t.Errorf("Add(a) after Remove(a) = %v, want [a b]", slices.Collect(s.Values()))
+	}
+
+	if got, want := len(slices.Collect(s.Values())), 2; got != want {
+		t.Errorf("Values() yields %d values, want %d", got, want)
+	}
+}
+
+func TestNewHashSet(t *testing.T) {
+	s := NewHashSet[int](100, 0.5)
+	slots := len(s.slots)
+
+	for i := range 100 {
+		s.Add(i)
+	}
+
+	if len(s.slots) != slots {
+		t.Errorf("NewHashSet(100, 0.5) resized from %d to %d slots after 100 values", slots, len(s.slots))
+	}
+	if load := float64(s.len) / float64(len(s.slots)); load > 0.5 {
+		t.Errorf("load = %v, want <= 0.5", load)
+	}
+
+	for _, loadFactor := range []float64{0, 1, -0.5, 1.5} {
+		if !panics(func() { NewHashSet[int](1, loadFactor) }) {
+			t.Errorf("NewHashSet(1, %v) expected to panic", loadFactor)
+		}
+	}
+}
+
+func TestHashSetTombstones(t *testing.T) {
+	s := &HashSet[int]{}
+
+	// churn through values so tombstones would fill the table
+	// if they were never compacted
+	for i := range 10000 {
+		s.Add(i)
+		if i >= 10 {
+			s.Remove(i - 10)
+		}
+
+		if s.tombstones > s.len || s.len+s.tombstones >= len(s.slots) {
+			t.Fatalf("%d: %d values and %d tombstones in %d slots", i, s.len, s.tombstones, len(s.slots))
+		}
+	}
+
+	if s.Len() != 10 || len(s.slots) > 64 {
+		t.Errorf("HashSet holds %d values in %d slots, want 10 values in at most 64", s.Len(), len(s.slots))
+	}
+
+	for s.Len() > 0 {
+		for v := range s.Values() {
+			s.Remove(v)
+			break
+		}
+	}
+	if len(s.slots) != minHashSetCap {
+		t.Errorf("empty HashSet has %d slots, want %d", len(s.slots), minHashSetCap)
+	}
+}
+
+// TestHashSetRandom compares HashSet with a map through random operations.
+func TestHashSetRandom(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	s := &HashSet[int]{}
+	want := map[int]struct{}{}
+
+	for i := range 20000 {
+		v := r.IntN(2000)
+		_, found := want[v]
+
+		switch r.IntN(3) {
+		case 0:
+			if got := s.Remove(v); got != found {
+				t.Fatalf("%d: Remove(%d) = %v, want %v", i, v, got, found)
+			}
+			delete(want, v)
+		case 1:
+			if got := s.Add(v); got == found {
+				t.Fatalf("%d: Add(%d) = %v, want %v", i, v, got, !found)
+			}
+			want[v] = struct{}{}
+		case 2:
+			if got := s.Has(v); got != found {
+				t.Fatalf("%d: Has(%d) = %v, want %v", i, v, got, found)
+			}
+		}
+	}
+
+	if s.Len() != len(want) {
+		t.Errorf("Len() = %d, want %d", s.Len(), len(want))
+	}
+	for v := range s.Values() {
+		if _, ok := want[v]; !ok {
+			t.Errorf("Values() yields %d, which was not added", v)
+		}
+	}
+}
+
+func BenchmarkSets(b *testing.B) {
+	const length = 10000
+
+	sets := []struct {
+		name   string
+		newSet func() Set[int]
+	}{
+		{"ArraySet", func() Set[int] { return &ArraySet[int]{} }},
+		{"OrderedArraySet", func() Set[int] { return &OrderedArraySet[int]{} }},
+		{"HashSet", func() Set[int] { return &HashSet[int]{} }},
+		{"TreeSet", func() Set[int] { return &TreeSet[int]{} }},
+		{"map", func() Set[int] { return mapSet[int]{} }},
+	}
+
+	for _, set := range sets {
+		b.Run(fmt.Sprintf("%s Add size = %d", set.name, length), func(b *testing.B) {
+			b.ReportAllocs()
+			for range b.N {
+				s := set.newSet()
+				for i := range length {
+					s.Add((i * 7919) % length)
+				}
+			}
+		})
+	}
+
+	for _, set := range sets {
+		b.Run(fmt.Sprintf("%s Has size = %d", set.name, length), func(b *testing.B) {
+			s := set.newSet()
+			for i := range length {
+				s.Add(i)
+			}
+
+			b.ResetTimer()
+			for i := range b.N {
+				s.Has(i % (2 * length))
+			}
+		})
+	}
+}
+
+// mapSet is the map[T]struct{} baseline for benchmarks.
+type mapSet[T comparable] map[T]struct{}
+
+func (s mapSet[T]) Add(value T) bool {
+	if _, ok := s[value]; ok {
+		return false
+	}
+	s[value] = struct{}{}
+	return true
+}
+
+func (s mapSet[T]) Has(value T) bool {
+	_, ok := s[value]
+	return ok
+}
+
+func (s mapSet[T]) Remove(value T) bool {
+	_, ok := s[value]
+	delete(s, value)
+	return ok
+}
+
+func (s mapSet[T]) Len() int {
+	return len(s)
+}
+
+func (s mapSet[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range s {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
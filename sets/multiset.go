@@ -0,0 +1,128 @@
+package sets
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+)
+
+// Multiset is the interface for multiset implementations.
+// A Multiset, also known as a bag, is an abstract data structure
+// like [Set] that allows duplicate values by counting their occurrences.
+//
+// [MultisetUnion] and [MultisetIntersection] return a [HashMultiset],
+// or an [OrderedMultiset] built by a linear merge when both operands
+// are OrderedMultisets.
+type Multiset[T comparable] interface {
+	// Add adds n occurrences of value to Multiset and returns its new count.
+	// It panics if n is negative.
+	Add(value T, n int) int
+	// Remove removes up to n occurrences of value from Multiset and
+	// returns how many were removed.
+	// It panics if n is negative.
+	Remove(value T, n int) int
+	// Count returns the number of occurrences of value.
+	Count(value T) int
+	// Len returns Multiset's length, counting every occurrence.
+	Len() int
+	// Distinct returns the number of distinct values in Multiset.
+	Distinct() int
+	// All returns an iterator over Multiset's value-count pairs.
+	All() iter.Seq2[T, int]
+	// MostCommon returns the k values with the highest counts, or all
+	// of them if there are fewer, in descending order of count.
+	// It panics if k is negative.
+	MostCommon(k int) []ValueCount[T]
+}
+
+// ValueCount is a value paired with its number of occurrences.
+type ValueCount[T comparable] struct {
+	Value T
+	Count int
+}
+
+// countMerger is implemented by multisets that can combine with
+// multisets of their own type faster than through [Multiset.Count] lookups.
+type countMerger[T comparable] interface {
+	// mergeCounts returns the union of the receiver and other, or their
+	// intersection if union is false, and reports whether other's type
+	// is supported.
+	mergeCounts(other Multiset[T], union bool) (Multiset[T], bool)
+}
+
+// MultisetUnion returns a new Multiset where each value counts
+// the maximum of its counts in a and b.
+//
+// Time O(n+m) expected and space O(n+m), where n and m are the
+// distinct values of a and b.
+func MultisetUnion[T comparable](a, b Multiset[T]) Multiset[T] {
+	if m, ok := a.(countMerger[T]); ok {
+		if r, ok := m.mergeCounts(b, true); ok {
+			return r
+		}
+	}
+
+	r := NewHashMultiset[T](a.Distinct() + b.Distinct())
+	for v, c := range a.All() {
+		r.Add(v, max(c, b.Count(v)))
+	}
+	for v, c := range b.All() {
+		if a.Count(v) == 0 {
+			r.Add(v, c)
+		}
+	}
+
+	return r
+}
+
+// MultisetIntersection returns a new Multiset where each value counts
+// the minimum of its counts in a and b.
+//
+// Time O(min(n, m)) lookups and space O(min(n, m)), where n and m
+// are the distinct values of a and b.
+func MultisetIntersection[T comparable](a, b Multiset[T]) Multiset[T] {
+	if m, ok := a.(countMerger[T]); ok {
+		if r, ok := m.mergeCounts(b, false); ok {
+			return r
+		}
+	}
+
+	if a.Distinct() > b.Distinct() {
+		a, b = b, a
+	}
+
+	r := NewHashMultiset[T](a.Distinct())
+	for v, c := range a.All() {
+		if c = min(c, b.Count(v)); c > 0 {
+			r.Add(v, c)
+		}
+	}
+
+	return r
+}
+
+// mostCommon returns the k pairs of all with the highest counts in
+// descending order of count, keeping all's order between equal counts.
+func mostCommon[T comparable](all iter.Seq2[T, int], distinct, k int) []ValueCount[T] {
+	if k < 0 {
+		panic(fmt.Sprintf("negative count %d", k))
+	}
+
+	pairs := make([]ValueCount[T], 0, distinct)
+	for v, c := range all {
+		pairs = append(pairs, ValueCount[T]{v, c})
+	}
+
+	slices.SortStableFunc(pairs, func(a, b ValueCount[T]) int {
+		return b.Count - a.Count
+	})
+
+	return pairs[:min(k, len(pairs))]
+}
+
+// checkCount panics if n, an occurrence count argument, is negative.
+func checkCount(n int) {
+	if n < 0 {
+		panic(fmt.Sprintf("negative count %d", n))
+	}
+}
@@ -0,0 +1,162 @@
+package sets
+
+import (
+	"maps"
+	"reflect"
+	"testing"
+)
+
+// multisetKinds builds a Multiset of each implementation
+// holding the given counts.
+var multisetKinds = []kind[Multiset[int], map[int]int]{
+	{"HashMultiset", func(counts map[int]int) Multiset[int] { return fillMultiset(&HashMultiset[int]{}, counts) }},
+	{"OrderedMultiset", func(counts map[int]int) Multiset[int] { return fillMultiset(&OrderedMultiset[int]{}, counts) }},
+}
+
+func fillMultiset(m Multiset[int], counts map[int]int) Multiset[int] {
+	for v, c := range counts {
+		m.Add(v, c)
+	}
+
+	return m
+}
+
+// testMultiset runs the conformance suite every [Multiset]
+// implementation must pass. newMultiset must return an empty Multiset.
+func testMultiset(t *testing.T, newMultiset func() Multiset[int]) {
+	t.Helper()
+
+	t.Run("Add Remove Count", func(t *testing.T) {
+		m := newMultiset()
+
+		if got := m.Add(1, 2); got != 2 {
+			t.Errorf("Add(1, 2) = %d, want 2", got)
+		}
+		if got := m.Add(1, 3); got != 5 {
+			t.Errorf("Add(1, 3) = %d, want 5", got)
+		}
+		if got := m.Add(2, 0); got != 0 {
+			t.Errorf("Add(2, 0) = %d, want 0", got)
+		}
+		m.Add(3, 1)
+
+		if got := m.Remove(1, 4); got != 4 {
+			t.Errorf("Remove(1, 4) = %d, want 4", got)
+		}
+		if got := m.Remove(3, 5); got != 1 {
+			t.Errorf("Remove(3, 5) = %d, want 1", got)
+		}
+		if got := m.Remove(4, 1); got != 0 {
+			t.Errorf("Remove(4, 1) = %d, want 0", got)
+		}
+
+		for value, want := range map[int]int{1: 1, 2: 0, 3: 0, 4: 0} {
+			if got := m.Count(value); got != want {
+				t.Errorf("Count(%d) = %d, want %d", value, got, want)
+			}
+		}
+
+		if got := m.Len(); got != 1 {
+			t.Errorf("Len() = %d, want 1", got)
+		}
+		if got := m.Distinct(); got != 1 {
+			t.Errorf("Distinct() = %d, want 1", got)
+		}
+		if got, want := maps.Collect(m.All()), map[int]int{1: 1}; !maps.Equal(got, want) {
+			t.Errorf("All() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("negative count", func(t *testing.T) {
+		m := newMultiset()
+
+		if !panics(func() { m.Add(1, -1) }) {
+			t.Errorf("Add(1, -1) expected to panic")
+		}
+		if !panics(func() { m.Remove(1, -1) }) {
+			t.Errorf("Remove(1, -1) expected to panic")
+		}
+		if !panics(func() { m.MostCommon(-1) }) {
+			t.Errorf("MostCommon(-1) expected to panic")
+		}
+	})
+
+	t.Run("MostCommon", func(t *testing.T) {
+		m := fillMultiset(newMultiset(), map[int]int{1: 1, 2: 5, 3: 3, 4: 4})
+
+		tests := []struct {
+			k    int
+			want []ValueCount[int]
+		}{
+			{0, []ValueCount[int]{}},
+			{2, []ValueCount[int]{{2, 5}, {4, 4}}},
+			{10, []ValueCount[int]{{2, 5}, {4, 4}, {3, 3}, {1, 1}}},
+		}
+
+		for i, test := range tests {
+			if got := m.MostCommon(test.k); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("%d: MostCommon(%d) = %v, want %v", i, test.k, got, test.want)
+			}
+		}
+	})
+}
+
+func TestMultisetOperations(t *testing.T) {
+	tests := []struct {
+		a, b             map[int]int
+		wantUnion        map[int]int
+		wantIntersection map[int]int
+	}{
+		{
+			map[int]int{},
+			map[int]int{},
+			map[int]int{},
+			map[int]int{},
+		},
+		{
+			map[int]int{1: 2},
+			map[int]int{},
+			map[int]int{1: 2},
+			map[int]int{},
+		},
+		{
+			map[int]int{1: 2, 2: 1, 3: 4},
+			map[int]int{2: 3, 3: 1, 5: 1},
+			map[int]int{1: 2, 2: 3, 3: 4, 5: 1},
+			map[int]int{2: 1, 3: 1},
+		},
+	}
+
+	for kindA, kindB := range kindPairs(multisetKinds) {
+		for i, test := range tests {
+			a, b := kindA.build(test.a), kindB.build(test.b)
+
+			union := MultisetUnion(a, b)
+			if got := maps.Collect(union.All()); !maps.Equal(got, test.wantUnion) {
+				t.Errorf("%d: MultisetUnion(%s%v, %s%v) = %v, want %v",
+					i, kindA.name, test.a, kindB.name, test.b, got, test.wantUnion)
+			}
+
+			intersection := MultisetIntersection(a, b)
+			if got := maps.Collect(intersection.All()); !maps.Equal(got, test.wantIntersection) {
+				t.Errorf("%d: MultisetIntersection(%s%v, %s%v) = %v, want %v",
+					i, kindA.name, test.a, kindB.name, test.b, got, test.wantIntersection)
+			}
+
+			wantLen := 0
+			for _, c := range test.wantUnion {
+				wantLen += c
+			}
+			if union.Len() != wantLen {
+				t.Errorf("%d: MultisetUnion(%s%v, %s%v).Len() = %d, want %d",
+					i, kindA.name, test.a, kindB.name, test.b, union.Len(), wantLen)
+			}
+
+			if kindA.name == "OrderedMultiset" && kindB.name == "OrderedMultiset" {
+				if _, ok := union.(*OrderedMultiset[int]); !ok {
+					t.Errorf("%d: MultisetUnion of OrderedMultisets returned %T", i, union)
+				}
+			}
+		}
+	}
+}
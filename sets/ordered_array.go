@@ -2,14 +2,15 @@ package sets
 
 import (
 	"cmp"
+	"fmt"
 	"iter"
 
 	"dsa/bisect"
 )
 
-var _ Set[int] = &OrderedArraySet[int]{}
+var _ OrderedSet[int] = &OrderedArraySet[int]{}
 
-// OrderedArraySet is a [Set] implementation that uses an ordered array underneath.
+// OrderedArraySet is an [OrderedSet] implementation that uses an ordered array underneath.
 type OrderedArraySet[T cmp.Ordered] struct {
 	arr []T
 }
@@ -75,3 +76,154 @@ func (s *OrderedArraySet[T]) Values() iter.Seq[T] {
 		}
 	}
 }
+
+// Min attempts to return OrderedArraySet's lowest value
+// and reports whether it succeeded.
+//
+// Time O(1) and space O(1).
+func (s *OrderedArraySet[T]) Min() (T, bool) {
+	return s.at(0)
+}
+
+// Max attempts to return OrderedArraySet's highest value
+// and reports whether it succeeded.
+//
+// Time O(1) and space O(1).
+func (s *OrderedArraySet[T]) Max() (T, bool) {
+	return s.at(len(s.arr) - 1)
+}
+
+// Floor attempts to return the highest value lower than or equal
+// to value and reports whether it succeeded.
+//
+// Time O(log(n)) and space O(1).
+func (s *OrderedArraySet[T]) Floor(value T) (T, bool) {
+	return s.at(bisect.BisectRight(s.arr, value) - 1)
+}
+
+// Ceiling attempts to return the lowest value higher than or equal
+// to value and reports whether it succeeded.
+//
+// Time O(log(n)) and space O(1).
+func (s *OrderedArraySet[T]) Ceiling(value T) (T, bool) {
+	return s.at(bisect.BisectLeft(s.arr, value))
+}
+
+// Lower attempts to return the highest value lower than value
+// and reports whether it succeeded.
+//
+// Time O(log(n)) and space O(1).
+func (s *OrderedArraySet[T]) Lower(value T) (T, bool) {
+	return s.at(bisect.BisectLeft(s.arr, value) - 1)
+}
+
+// Higher attempts to return the lowest value higher than value
+// and reports whether it succeeded.
+//
+// Time O(log(n)) and space O(1).
+func (s *OrderedArraySet[T]) Higher(value T) (T, bool) {
+	return s.at(bisect.BisectRight(s.arr, value))
+}
+
+// Rank returns the number of values lower than value.
+//
+// Time O(log(n)) and space O(1).
+func (s *OrderedArraySet[T]) Rank(value T) int {
+	return bisect.BisectLeft(s.arr, value)
+}
+
+// Select returns the value at the provided index in ascending order.
+// It panics if index is out of bounds.
+//
+// Time O(1) and space O(1).
+func (s *OrderedArraySet[T]) Select(index int) T {
+	if index < 0 || index >= len(s.arr) {
+		panic(fmt.Sprintf("index out of range [%d] with length %d", index, len(s.arr)))
+	}
+
+	return s.arr[index]
+}
+
+// Range returns an iterator over OrderedArraySet elements
+// in range [lo, hi) in ascending order.
+func (s *OrderedArraySet[T]) Range(lo, hi T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := bisect.BisectLeft(s.arr, lo); i < len(s.arr) && s.arr[i] < hi; i++ {
+			if !yield(s.arr[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator of OrderedArraySet elements
+// in descending order.
+func (s *OrderedArraySet[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := len(s.arr) - 1; i >= 0; i-- {
+			if !yield(s.arr[i]) {
+				return
+			}
+		}
+	}
+}
+
+// at attempts to return the value at index
+// and reports whether it is in bounds.
+func (s *OrderedArraySet[T]) at(index int) (T, bool) {
+	if index < 0 || index >= len(s.arr) {
+		var v T
+		return v, false
+	}
+
+	return s.arr[index], true
+}
+
+// merge implements [merger] by merging two OrderedArraySets
+// linearly, like merge sort does.
+//
+// Time O(n+m) and space O(n+m).
+func (s *OrderedArraySet[T]) merge(other Set[T], op setOp) (Set[T], bool) {
+	o, ok := other.(*OrderedArraySet[T])
+	if !ok {
+		return nil, false
+	}
+
+	keepA := op != opIntersection
+	keepB := op == opUnion || op == opSymmetricDifference
+	keepBoth := op == opUnion || op == opIntersection
+
+	a, b := s.arr, o.arr
+	var arr []T
+
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] < b[j]:
+			if keepA {
+				arr = append(arr, a[i])
+			}
+			i++
+		case a[i] > b[j]:
+			if keepB {
+				arr = append(arr, b[j])
+			}
+			j++
+		default:
+			if keepBoth {
+				arr = append(arr, a[i])
+			}
+			i++
+			j++
+		}
+	}
+
+	if keepA {
+		arr = append(arr, a[i:]...)
+	}
+	if keepB {
+		arr = append(arr, b[j:]...)
+	}
+
+	return &OrderedArraySet[T]{arr: arr}, true
+}
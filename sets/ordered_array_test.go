@@ -144,3 +144,7 @@ func TestOrderedArraySetValues(t *testing.T) {
 		}
 	}
 }
+
+func TestOrderedArraySetOrdered(t *testing.T) {
+	testOrderedSet(t, func() OrderedSet[int] { return &OrderedArraySet[int]{} })
+}
@@ -0,0 +1,177 @@
+package sets
+
+import (
+	"cmp"
+	"iter"
+	"slices"
+)
+
+var _ Multiset[int] = &OrderedMultiset[int]{}
+
+// OrderedMultiset is a [Multiset] implementation that uses an ordered
+// array of distinct values, and a parallel array of their counts, underneath.
+//
+// Values are ordered by [cmp.Compare], so a float NaN sorts first
+// and all its occurrences are counted together.
+//
+// The zero value is an empty OrderedMultiset ready to use.
+type OrderedMultiset[T cmp.Ordered] struct {
+	values []T
+	counts []int
+	len    int
+}
+
+// Add adds n occurrences of value to OrderedMultiset and returns its new count.
+// It panics if n is negative.
+//
+// Time O(n) and space O(1), or O(log(n)) if value is present.
+func (m *OrderedMultiset[T]) Add(value T, n int) int {
+	checkCount(n)
+
+	i, found := m.index(value)
+
+	if found {
+		m.counts[i] += n
+		m.len += n
+		return m.counts[i]
+	}
+
+	if n == 0 {
+		return 0
+	}
+
+	m.values = slices.Insert(m.values, i, value)
+	m.counts = slices.Insert(m.counts, i, n)
+	m.len += n
+
+	return n
+}
+
+// Remove removes up to n occurrences of value from OrderedMultiset
+// and returns how many were removed.
+// It panics if n is negative.
+//
+// Time O(n) and space O(1), or O(log(n)) if some occurrences are left.
+func (m *OrderedMultiset[T]) Remove(value T, n int) int {
+	checkCount(n)
+
+	i, found := m.index(value)
+
+	if !found {
+		return 0
+	}
+
+	removed := min(n, m.counts[i])
+
+	if removed == m.counts[i] {
+		m.values = slices.Delete(m.values, i, i+1)
+		m.counts = slices.Delete(m.counts, i, i+1)
+	} else {
+		m.counts[i] -= removed
+	}
+	m.len -= removed
+
+	return removed
+}
+
+// Count returns the number of occurrences of value.
+//
+// Time O(log(n)) and space O(1).
+func (m *OrderedMultiset[T]) Count(value T) int {
+	if i, found := m.index(value); found {
+		return m.counts[i]
+	}
+
+	return 0
+}
+
+// Len returns OrderedMultiset's length, counting every occurrence.
+func (m *OrderedMultiset[T]) Len() int {
+	return m.len
+}
+
+// Distinct returns the number of distinct values in OrderedMultiset.
+func (m *OrderedMultiset[T]) Distinct() int {
+	return len(m.values)
+}
+
+// All returns an iterator over OrderedMultiset's value-count pairs
+// in ascending order of value.
+func (m *OrderedMultiset[T]) All() iter.Seq2[T, int] {
+	return func(yield func(T, int) bool) {
+		for i, v := range m.values {
+			if !yield(v, m.counts[i]) {
+				return
+			}
+		}
+	}
+}
+
+// MostCommon returns the k values with the highest counts, or all
+// of them if there are fewer, in descending order of count.
+// Values with equal counts are in ascending order.
+// It panics if k is negative.
+//
+// Time O(d*log(d)) and space O(d), where d is the number of distinct values.
+func (m *OrderedMultiset[T]) MostCommon(k int) []ValueCount[T] {
+	return mostCommon(m.All(), len(m.values), k)
+}
+
+// mergeCounts implements [countMerger] by merging two OrderedMultisets
+// linearly, like merge sort does.
+//
+// Time O(n+m) and space O(n+m).
+func (m *OrderedMultiset[T]) mergeCounts(other Multiset[T], union bool) (Multiset[T], bool) {
+	o, ok := other.(*OrderedMultiset[T])
+	if !ok {
+		return nil, false
+	}
+
+	r := &OrderedMultiset[T]{}
+	push := func(value T, count int) {
+		r.values = append(r.values, value)
+		r.counts = append(r.counts, count)
+		r.len += count
+	}
+
+	i, j := 0, 0
+	for i < len(m.values) && j < len(o.values) {
+		switch a, b := m.values[i], o.values[j]; cmp.Compare(a, b) {
+		case -1:
+			if union {
+				push(a, m.counts[i])
+			}
+			i++
+		case 1:
+			if union {
+				push(b, o.counts[j])
+			}
+			j++
+		default:
+			if union {
+				push(a, max(m.counts[i], o.counts[j]))
+			} else {
+				push(a, min(m.counts[i], o.counts[j]))
+			}
+			i++
+			j++
+		}
+	}
+
+	if union {
+		for ; i < len(m.values); i++ {
+			push(m.values[i], m.counts[i])
+		}
+		for ; j < len(o.values); j++ {
+			push(o.values[j], o.counts[j])
+		}
+	}
+
+	return r, true
+}
+
+// index returns the position of value, or where it would be inserted,
+// and reports whether it was found.
+func (m *OrderedMultiset[T]) index(value T) (int, bool) {
+	return slices.BinarySearchFunc(m.values, value, cmp.Compare[T])
+}
@@ -0,0 +1,65 @@
+package sets
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestOrderedMultiset(t *testing.T) {
+	testMultiset(t, func() Multiset[int] { return &OrderedMultiset[int]{} })
+}
+
+func TestOrderedMultisetOrder(t *testing.T) {
+	m := &OrderedMultiset[string]{}
+	for _, v := range []string{"c", "a", "b", "c", "a", "d"} {
+		m.Add(v, 1)
+	}
+
+	var got []ValueCount[string]
+	for v, c := range m.All() {
+		got = append(got, ValueCount[string]{v, c})
+	}
+	if want := []ValueCount[string]{{"a", 2}, {"b", 1}, {"c", 2}, {"d", 1}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("All() = %v, want %v", got, want)
+	}
+
+	// equal counts keep ascending order
+	if got, want := m.MostCommon(3), []ValueCount[string]{{"a", 2}, {"c", 2}, {"b", 1}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("MostCommon(3) = %v, want %v", got, want)
+	}
+}
+
+func TestOrderedMultisetNaN(t *testing.T) {
+	nan := math.NaN()
+
+	m := &OrderedMultiset[float64]{}
+	m.Add(nan, 1)
+	m.Add(nan, 1)
+	m.Add(1, 1)
+
+	if got := m.Distinct(); got != 2 {
+		t.Errorf("Distinct() = %d, want 2", got)
+	}
+	if got := m.Count(nan); got != 2 {
+		t.Errorf("Count(NaN) = %d, want 2", got)
+	}
+
+	o := &OrderedMultiset[float64]{}
+	o.Add(nan, 3)
+	o.Add(2, 1)
+
+	if got := MultisetUnion[float64](m, o).Count(nan); got != 3 {
+		t.Errorf("MultisetUnion(...).Count(NaN) = %d, want 3", got)
+	}
+	if got := MultisetIntersection[float64](m, o); got.Count(nan) != 2 || got.Len() != 2 {
+		t.Errorf("MultisetIntersection(...) = %d NaNs of %d values, want 2 of 2", got.Count(nan), got.Len())
+	}
+
+	if got := m.Remove(nan, 5); got != 2 {
+		t.Errorf("Remove(NaN, 5) = %d, want 2", got)
+	}
+	if got := m.Distinct(); got != 1 {
+		t.Errorf("Distinct() after Remove = %d, want 1", got)
+	}
+}
@@ -0,0 +1,102 @@
+package probabilistic
+
+import (
+	"iter"
+	"math"
+	"math/bits"
+)
+
+var _ Filter[int] = &BloomFilter[int]{}
+
+// BloomFilter is a [Filter] implementation that uses an array of m bits
+// underneath. Adding a value sets the bits at its k hashed positions, and
+// a value may happen if all of them are set.
+//
+// A BloomFilter must be created with [NewBloomFilter]. The zero value
+// has no bits, and using it panics rather than reporting every value.
+type BloomFilter[T comparable] struct {
+	words  []uint64
+	hasher hasher[T]
+	len    int
+}
+
+// NewBloomFilter returns an empty BloomFilter sized to hold n values
+// with a false positive rate of at most p.
+// It panics if n is not positive or p is not in range (0, 1).
+func NewBloomFilter[T comparable](n int, p float64) *BloomFilter[T] {
+	m, k := bloomParams(n, p)
+
+	return &BloomFilter[T]{
+		words:  make([]uint64, (m+63)/64),
+		hasher: newHasher[T](k, m),
+	}
+}
+
+// Add adds value to BloomFilter and reports whether it succeed,
+// which is when any of its bits was unset. It fails for values
+// already added, and for new ones that are false positives.
+//
+// Time O(k) and space O(1).
+func (f *BloomFilter[T]) Add(value T) bool {
+	added := false
+	for i := range f.positions(value) {
+		if word, bit := &f.words[i/64], uint64(1)<<(i%64); *word&bit == 0 {
+			*word |= bit
+			added = true
+		}
+	}
+
+	if added {
+		f.len++
+	}
+
+	return added
+}
+
+// Has reports whether value may happen in BloomFilter.
+// It may return false positives but never false negatives.
+//
+// Time O(k) and space O(1).
+func (f *BloomFilter[T]) Has(value T) bool {
+	for i := range f.positions(value) {
+		if f.words[i/64]&(1<<(i%64)) == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Len returns the number of successful adds, which is
+// a lower bound of the number of distinct values added.
+func (f *BloomFilter[T]) Len() int {
+	return f.len
+}
+
+// FalsePositiveRate returns the probability of Has returning true for
+// a value never added, estimated from the fraction of set bits.
+//
+// Time O(m) and space O(1).
+func (f *BloomFilter[T]) FalsePositiveRate() float64 {
+	f.mustBeCreated()
+
+	set := 0
+	for _, w := range f.words {
+		set += bits.OnesCount64(w)
+	}
+
+	return math.Pow(float64(set)/float64(f.hasher.m), float64(f.hasher.k))
+}
+
+// positions returns an iterator over value's k bit positions.
+func (f *BloomFilter[T]) positions(value T) iter.Seq[uint64] {
+	f.mustBeCreated()
+
+	return f.hasher.positions(value)
+}
+
+func (f *BloomFilter[T]) mustBeCreated() {
+	if f.hasher.k == 0 {
+		panic("BloomFilter not created with NewBloomFilter")
+	}
+}
@@ -0,0 +1,37 @@
+package probabilistic
+
+import "testing"
+
+func TestBloomFilterFalsePositiveRate(t *testing.T) {
+	const n = 10000
+
+	for _, p := range []float64{0.1, 0.01, 0.001} {
+		f := NewBloomFilter[int](n, p)
+
+		// allow for sampling noise over 100000 trials
+		if rate := testFalsePositiveRate(t, f, n); rate > 1.5*p {
+			t.Errorf("false positive rate = %v, want at most %v", rate, p)
+		}
+
+		if estimate := f.FalsePositiveRate(); estimate > 1.5*p {
+			t.Errorf("FalsePositiveRate() = %v, want at most %v", estimate, p)
+		}
+	}
+}
+
+func TestBloomFilterAdd(t *testing.T) {
+	f := NewBloomFilter[string](100, 0.01)
+
+	if !f.Add("a") {
+		t.Errorf("Add(%q) = false, want true", "a")
+	}
+	if f.Add("a") {
+		t.Errorf("Add(%q) = true for an added value, want false", "a")
+	}
+	if got := f.Len(); got != 1 {
+		t.Errorf("Len() = %d, want 1", got)
+	}
+	if !f.Has("a") {
+		t.Errorf("Has(%q) = false for an added value", "a")
+	}
+}
@@ -0,0 +1,105 @@
+package probabilistic
+
+import (
+	"iter"
+	"math"
+)
+
+var _ Filter[int] = &CountingBloomFilter[int]{}
+
+// CountingBloomFilter is a [Filter] implementation like [BloomFilter]
+// that uses an array of m 8-bit counters instead of bits underneath,
+// so values can be removed by decrementing their counters.
+//
+// Counters saturate at 255 and then stay there, since their true count
+// is unknown, which rarely happens with the default sizing.
+//
+// A CountingBloomFilter must be created with [NewCountingBloomFilter].
+// The zero value has no counters, and using it panics rather than
+// reporting every value.
+type CountingBloomFilter[T comparable] struct {
+	counters []uint8
+	hasher   hasher[T]
+	len      int
+}
+
+// NewCountingBloomFilter returns an empty CountingBloomFilter sized to
+// hold n values with a false positive rate of at most p.
+// It panics if n is not positive or p is not in range (0, 1).
+func NewCountingBloomFilter[T comparable](n int, p float64) *CountingBloomFilter[T] {
+	m, k := bloomParams(n, p)
+
+	return &CountingBloomFilter[T]{
+		counters: make([]uint8, m),
+		hasher:   newHasher[T](k, m),
+	}
+}
+
+// Add adds value to CountingBloomFilter, incrementing its counters,
+// and reports whether it succeed, which it always does. Adding a value
+// more than once requires removing it as many times.
+//
+// Time O(k) and space O(1).
+func (f *CountingBloomFilter[T]) Add(value T) bool {
+	for i := range f.positions(value) {
+		if f.counters[i] < math.MaxUint8 {
+			f.counters[i]++
+		}
+	}
+
+	f.len++
+
+	return true
+}
+
+// Has reports whether value may happen in CountingBloomFilter.
+// It may return false positives but never false negatives,
+// as long as only added values are removed.
+//
+// Time O(k) and space O(1).
+func (f *CountingBloomFilter[T]) Has(value T) bool {
+	for i := range f.positions(value) {
+		if f.counters[i] == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Remove removes value from CountingBloomFilter, decrementing its
+// counters, and reports whether it may have happened.
+//
+// Removing a false positive, a value never added, decrements counters
+// of other values and may cause false negatives for them.
+//
+// Time O(k) and space O(1).
+func (f *CountingBloomFilter[T]) Remove(value T) bool {
+	if !f.Has(value) {
+		return false
+	}
+
+	for i := range f.positions(value) {
+		if f.counters[i] < math.MaxUint8 {
+			f.counters[i]--
+		}
+	}
+
+	f.len--
+
+	return true
+}
+
+// Len returns the number of values added and not removed.
+func (f *CountingBloomFilter[T]) Len() int {
+	return f.len
+}
+
+// positions returns an iterator over value's k counter positions.
+func (f *CountingBloomFilter[T]) positions(value T) iter.Seq[uint64] {
+	if f.hasher.k == 0 {
+		panic("CountingBloomFilter not created with NewCountingBloomFilter")
+	}
+
+	return f.hasher.positions(value)
+}
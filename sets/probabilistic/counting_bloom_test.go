@@ -0,0 +1,58 @@
+package probabilistic
+
+import "testing"
+
+func TestCountingBloomFilterFalsePositiveRate(t *testing.T) {
+	const (
+		n = 10000
+		p = 0.01
+	)
+
+	f := NewCountingBloomFilter[int](n, p)
+
+	if rate := testFalsePositiveRate(t, f, n); rate > 1.5*p {
+		t.Errorf("false positive rate = %v, want at most %v", rate, p)
+	}
+}
+
+func TestCountingBloomFilterRemove(t *testing.T) {
+	f := NewCountingBloomFilter[int](1000, 0.01)
+
+	for v := range 1000 {
+		f.Add(v)
+	}
+	f.Add(0)
+
+	for v := 1; v < 1000; v += 2 {
+		if !f.Remove(v) {
+			t.Fatalf("Remove(%d) = false for an added value", v)
+		}
+	}
+
+	for v := 0; v < 1000; v += 2 {
+		if !f.Has(v) {
+			t.Errorf("Has(%d) = false for a value not removed", v)
+		}
+	}
+	if got := f.Len(); got != 501 {
+		t.Errorf("Len() = %d, want 501", got)
+	}
+
+	// 0 was added twice
+	f.Remove(0)
+	if !f.Has(0) {
+		t.Errorf("Has(0) = false after removing one of two adds")
+	}
+	f.Remove(0)
+
+	// all counters of even values are back to their own adds,
+	// so removing them all empties the filter
+	for v := 2; v < 1000; v += 2 {
+		f.Remove(v)
+	}
+	for i, c := range f.counters {
+		if c != 0 {
+			t.Fatalf("counters[%d] = %d after removing every value, want 0", i, c)
+		}
+	}
+}
@@ -0,0 +1,189 @@
+package probabilistic
+
+import (
+	"fmt"
+	"hash/maphash"
+	"math/bits"
+	"math/rand/v2"
+)
+
+var _ Filter[int] = &CuckooFilter[int]{}
+
+const (
+	// bucketSize is the number of fingerprints each bucket holds.
+	bucketSize = 4
+	// maxKicks bounds the relocations done by a single add
+	// before the filter is considered full.
+	maxKicks = 500
+	// cuckooLoadFactor is the fraction of slots NewCuckooFilter
+	// expects to fill, which buckets of 4 reach reliably.
+	cuckooLoadFactor = 0.95
+)
+
+// fingerprint is a 16-bit value hash, never 0, which marks empty slots.
+type fingerprint uint16
+
+type bucket [bucketSize]fingerprint
+
+// CuckooFilter is a [Filter] implementation that uses cuckoo hashing
+// underneath, storing a 16-bit fingerprint of each value in one of
+// two candidate buckets. The second bucket is derived from the first
+// and the fingerprint alone, so fingerprints can be relocated without
+// their values, making room for new ones as in a cuckoo hash table.
+//
+// Unlike Bloom filters it supports removing values, and its false
+// positive rate of about 8/2^16 holds until it is full.
+//
+// A CuckooFilter must be created with [NewCuckooFilter]. The zero value
+// has no buckets, and using it panics.
+type CuckooFilter[T comparable] struct {
+	buckets []bucket
+	len     int
+	seed    maphash.Seed
+}
+
+// NewCuckooFilter returns an empty CuckooFilter sized to hold n values.
+// It panics if n is not positive.
+func NewCuckooFilter[T comparable](n int) *CuckooFilter[T] {
+	if n < 1 {
+		panic(fmt.Sprintf("expected values %d not positive", n))
+	}
+
+	// a power of two number of buckets makes alternate
+	// bucket indexes symmetric under xor
+	buckets := int(float64(n)/(bucketSize*cuckooLoadFactor)) + 1
+	buckets = 1 << bits.Len(uint(buckets-1))
+
+	return &CuckooFilter[T]{
+		buckets: make([]bucket, buckets),
+		seed:    maphash.MakeSeed(),
+	}
+}
+
+// Add adds value to CuckooFilter and reports whether it succeed, which
+// fails if CuckooFilter is full, leaving it unchanged. Adding a value
+// more than once stores it again, so it requires removing it as many times.
+//
+// Time O(1) amortized expected and space O(1).
+func (f *CuckooFilter[T]) Add(value T) bool {
+	fp, i1, i2 := f.locate(value)
+
+	if f.buckets[i1].insert(fp) || f.buckets[i2].insert(fp) {
+		f.len++
+		return true
+	}
+
+	// kick random fingerprints to their alternate buckets, recording
+	// the swaps to undo them if no free slot is found
+	type swap struct {
+		bucket, slot int
+	}
+	var swaps []swap
+
+	i := i1
+	if rand.IntN(2) == 0 {
+		i = i2
+	}
+
+	for range maxKicks {
+		slot := rand.IntN(bucketSize)
+		swaps = append(swaps, swap{i, slot})
+		fp, f.buckets[i][slot] = f.buckets[i][slot], fp
+
+		i = f.alternate(i, fp)
+		if f.buckets[i].insert(fp) {
+			f.len++
+			return true
+		}
+	}
+
+	for j := len(swaps) - 1; j >= 0; j-- {
+		s := swaps[j]
+		fp, f.buckets[s.bucket][s.slot] = f.buckets[s.bucket][s.slot], fp
+	}
+
+	return false
+}
+
+// Has reports whether value may happen in CuckooFilter.
+// It may return false positives but never false negatives,
+// as long as only added values are removed.
+//
+// Time O(1) and space O(1).
+func (f *CuckooFilter[T]) Has(value T) bool {
+	fp, i1, i2 := f.locate(value)
+
+	return f.buckets[i1].index(fp) != -1 || f.buckets[i2].index(fp) != -1
+}
+
+// Remove removes value from CuckooFilter and reports whether it may
+// have happened.
+//
+// Removing a false positive, a value never added, removes the
+// fingerprint of another value and causes a false negative for it.
+//
+// Time O(1) and space O(1).
+func (f *CuckooFilter[T]) Remove(value T) bool {
+	fp, i1, i2 := f.locate(value)
+
+	for _, i := range []int{i1, i2} {
+		if slot := f.buckets[i].index(fp); slot != -1 {
+			f.buckets[i][slot] = 0
+			f.len--
+			return true
+		}
+	}
+
+	return false
+}
+
+// Len returns the number of values added and not removed.
+func (f *CuckooFilter[T]) Len() int {
+	return f.len
+}
+
+// locate returns value's fingerprint and candidate bucket indexes.
+func (f *CuckooFilter[T]) locate(value T) (fingerprint, int, int) {
+	if len(f.buckets) == 0 {
+		panic("CuckooFilter not created with NewCuckooFilter")
+	}
+
+	h := maphash.Comparable(f.seed, value)
+
+	// the fingerprint uses the high bits, the index the low ones
+	fp := fingerprint(h >> 48)
+	if fp == 0 {
+		fp = 1
+	}
+
+	i1 := int(h & uint64(len(f.buckets)-1))
+
+	return fp, i1, f.alternate(i1, fp)
+}
+
+// alternate returns the other candidate bucket index of fingerprint fp
+// stored in bucket i. Applying it twice returns i.
+func (f *CuckooFilter[T]) alternate(i int, fp fingerprint) int {
+	return (i ^ int(maphash.Comparable(f.seed, fp))) & (len(f.buckets) - 1)
+}
+
+// insert stores fp in a free slot and reports whether there was one.
+func (b *bucket) insert(fp fingerprint) bool {
+	if slot := b.index(0); slot != -1 {
+		b[slot] = fp
+		return true
+	}
+
+	return false
+}
+
+// index returns the first slot holding fp or -1.
+func (b *bucket) index(fp fingerprint) int {
+	for slot, v := range b {
+		if v == fp {
+			return slot
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,63 @@
+package probabilistic
+
+import "testing"
+
+func TestCuckooFilterFalsePositiveRate(t *testing.T) {
+	const n = 10000
+
+	f := NewCuckooFilter[int](n)
+
+	// two buckets of four 16-bit fingerprints give about 8/65536
+	if rate := testFalsePositiveRate(t, f, n); rate > 0.0005 {
+		t.Errorf("false positive rate = %v, want at most 0.0005", rate)
+	}
+}
+
+func TestCuckooFilterRemove(t *testing.T) {
+	f := NewCuckooFilter[int](1000)
+
+	for v := range 1000 {
+		if !f.Add(v) {
+			t.Fatalf("Add(%d) = false", v)
+		}
+	}
+
+	for v := 0; v < 1000; v += 2 {
+		if !f.Remove(v) {
+			t.Fatalf("Remove(%d) = false for an added value", v)
+		}
+	}
+
+	for v := 1; v < 1000; v += 2 {
+		if !f.Has(v) {
+			t.Errorf("Has(%d) = false for a value not removed", v)
+		}
+	}
+	if got := f.Len(); got != 500 {
+		t.Errorf("Len() = %d, want 500", got)
+	}
+}
+
+func TestCuckooFilterFull(t *testing.T) {
+	f := NewCuckooFilter[int](1)
+	capacity := len(f.buckets) * bucketSize
+
+	var added []int
+	for v := range 2 * capacity {
+		if f.Add(v) {
+			added = append(added, v)
+		}
+	}
+
+	if len(added) > capacity || f.Len() != len(added) {
+		t.Errorf("added %d values with Len() = %d, want at most %d", len(added), f.Len(), capacity)
+	}
+
+	// a failed add leaves the filter unchanged, so
+	// every successfully added value is still found
+	for _, v := range added {
+		if !f.Has(v) {
+			t.Errorf("Has(%d) = false after failed adds", v)
+		}
+	}
+}
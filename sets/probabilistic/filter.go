@@ -0,0 +1,72 @@
+// Package probabilistic defines the Filter interface and all of it's
+// implementations. A Filter is a set that trades exact answers for
+// bounded memory: it may report values it never held, false positives,
+// but never misses values it holds.
+package probabilistic
+
+import (
+	"fmt"
+	"hash/maphash"
+	"iter"
+	"math"
+)
+
+// Filter is the interface for probabilistic set implementations,
+// similar to sets.Set but without iteration since values themselves
+// are not stored.
+type Filter[T comparable] interface {
+	// Add attempts to insert value to Filter and reports whether it succeed.
+	Add(value T) bool
+	// Has reports whether value may happen in Filter.
+	// It may return false positives but never false negatives.
+	Has(value T) bool
+}
+
+// hasher derives k positions in range [0, m) for each value by double
+// hashing, combining two independent hashes as h1 + i*h2, which is as
+// good as k independent hashes for Bloom filters.
+type hasher[T comparable] struct {
+	seeds [2]maphash.Seed
+	k     int
+	m     uint64
+}
+
+func newHasher[T comparable](k int, m uint64) hasher[T] {
+	return hasher[T]{
+		seeds: [2]maphash.Seed{maphash.MakeSeed(), maphash.MakeSeed()},
+		k:     k,
+		m:     m,
+	}
+}
+
+// positions returns an iterator over value's k positions.
+func (h hasher[T]) positions(value T) iter.Seq[uint64] {
+	return func(yield func(uint64) bool) {
+		h1 := maphash.Comparable(h.seeds[0], value)
+		h2 := maphash.Comparable(h.seeds[1], value)
+
+		for i := range uint64(h.k) {
+			if !yield((h1 + i*h2) % h.m) {
+				return
+			}
+		}
+	}
+}
+
+// bloomParams returns the number of positions m and hashes k that
+// keep the false positive rate of a Bloom filter holding n values at p.
+// It panics if n is not positive or p is not in range (0, 1).
+func bloomParams(n int, p float64) (m uint64, k int) {
+	if n < 1 {
+		panic(fmt.Sprintf("expected values %d not positive", n))
+	}
+	if !(p > 0 && p < 1) {
+		panic(fmt.Sprintf("false positive rate %v out of range (0, 1)", p))
+	}
+
+	// m = -n*ln(p) / ln(2)^2 and k = m/n * ln(2)
+	m = uint64(math.Ceil(-float64(n) * math.Log(p) / (math.Ln2 * math.Ln2)))
+	k = max(1, int(math.Round(float64(m)/float64(n)*math.Ln2)))
+
+	return m, k
+}
@@ -0,0 +1,103 @@
+package probabilistic
+
+import (
+	"math"
+	"testing"
+)
+
+// testFalsePositiveRate adds n values to f, checks none of them is
+// missed and returns the fraction of other values f reports.
+func testFalsePositiveRate(t *testing.T, f Filter[int], n int) float64 {
+	t.Helper()
+
+	for v := range n {
+		f.Add(v)
+	}
+
+	for v := range n {
+		if !f.Has(v) {
+			t.Fatalf("Has(%d) = false for an added value", v)
+		}
+	}
+
+	const trials = 100000
+
+	positives := 0
+	for v := n; v < n+trials; v++ {
+		if f.Has(v) {
+			positives++
+		}
+	}
+
+	return float64(positives) / trials
+}
+
+func TestBloomParams(t *testing.T) {
+	tests := []struct {
+		n     int
+		p     float64
+		wantM uint64
+		wantK int
+	}{
+		{1000, 0.01, 9586, 7},
+		{1000, 0.001, 14378, 10},
+		{1, 0.5, 2, 1},
+	}
+
+	for _, test := range tests {
+		if m, k := bloomParams(test.n, test.p); m != test.wantM || k != test.wantK {
+			t.Errorf("bloomParams(%d, %v) = (%d, %d), want (%d, %d)", test.n, test.p, m, k, test.wantM, test.wantK)
+		}
+	}
+
+	for _, p := range []float64{0, 1, -1, math.NaN()} {
+		if !panics(func() { bloomParams(10, p) }) {
+			t.Errorf("bloomParams(10, %v) expected to panic", p)
+		}
+	}
+	if !panics(func() { bloomParams(0, 0.01) }) {
+		t.Errorf("bloomParams(0, 0.01) expected to panic")
+	}
+}
+
+func TestFilterZeroValues(t *testing.T) {
+	filters := []struct {
+		name   string
+		filter Filter[int]
+	}{
+		{"BloomFilter", &BloomFilter[int]{}},
+		{"CountingBloomFilter", &CountingBloomFilter[int]{}},
+		{"CuckooFilter", &CuckooFilter[int]{}},
+	}
+
+	for _, f := range filters {
+		if !panics(func() { f.filter.Add(1) }) {
+			t.Errorf("%s: Add() on the zero value expected to panic", f.name)
+		}
+		if !panics(func() { f.filter.Has(1) }) {
+			t.Errorf("%s: Has() on the zero value expected to panic", f.name)
+		}
+	}
+
+	if !panics(func() { (&BloomFilter[int]{}).FalsePositiveRate() }) {
+		t.Errorf("BloomFilter: FalsePositiveRate() on the zero value expected to panic")
+	}
+	if !panics(func() { (&CountingBloomFilter[int]{}).Remove(1) }) {
+		t.Errorf("CountingBloomFilter: Remove() on the zero value expected to panic")
+	}
+	if !panics(func() { (&CuckooFilter[int]{}).Remove(1) }) {
+		t.Errorf("CuckooFilter: Remove() on the zero value expected to panic")
+	}
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		if e := recover(); e != nil {
+			panicked = true
+		}
+	}()
+
+	fn()
+
+	return panicked
+}
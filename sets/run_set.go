@@ -0,0 +1,258 @@
+package sets
+
+import (
+	"iter"
+	"math"
+	"slices"
+)
+
+var _ Set[uint] = &RunSet{}
+
+// RunSet is a [Set] implementation for non-negative integers that uses
+// run-length encoding underneath: an ordered array of disjoint runs of
+// consecutive values. Unlike [BitSet], its size depends on the number
+// of runs rather than on the highest value, so it is compact for
+// sparse sets spread over large ranges, or for long runs of values.
+//
+// The zero value is an empty RunSet ready to use.
+type RunSet struct {
+	// runs are sorted and never overlap nor touch,
+	// which keeps their representation unique.
+	runs []run
+	// count is the number of values modulo 2^64, which is exact
+	// except for a full RunSet, whose 2^64 values count as 0.
+	count uint
+}
+
+// run is a range [first, last] of consecutive values.
+type run struct {
+	first, last uint
+}
+
+// Has reports whether value happens in RunSet.
+//
+// Time O(log(r)) and space O(1), where r is the number of runs.
+func (s *RunSet) Has(value uint) bool {
+	i := s.search(value)
+
+	return i < len(s.runs) && s.runs[i].first <= value
+}
+
+// Add adds value to RunSet and reports whether it succeed.
+//
+// Time O(r) and space O(1), where r is the number of runs,
+// or O(log(r)) if value extends a run without merging two.
+func (s *RunSet) Add(value uint) bool {
+	if s.Has(value) {
+		return false
+	}
+
+	s.addRun(run{value, value})
+
+	return true
+}
+
+// Remove removes value from RunSet and reports whether it was found.
+//
+// Time O(r) and space O(1), where r is the number of runs,
+// or O(log(r)) if value is at either end of a run.
+func (s *RunSet) Remove(value uint) bool {
+	if !s.Has(value) {
+		return false
+	}
+
+	s.removeRun(run{value, value})
+
+	return true
+}
+
+// Len returns RunSet's length, or math.MaxInt if it holds more
+// values than an int can count, as large ranges easily do.
+func (s *RunSet) Len() int {
+	if s.count > math.MaxInt || s.count == 0 && len(s.runs) > 0 {
+		return math.MaxInt
+	}
+
+	return int(s.count)
+}
+
+// Values returns an iterator of RunSet elements in ascending order.
+func (s *RunSet) Values() iter.Seq[uint] {
+	return func(yield func(uint) bool) {
+		for _, r := range s.runs {
+			for v := r.first; ; v++ {
+				if !yield(v) {
+					return
+				}
+				if v == r.last {
+					break
+				}
+			}
+		}
+	}
+}
+
+// Runs returns an iterator over RunSet's runs as inclusive
+// [first, last] ranges in ascending order.
+func (s *RunSet) Runs() iter.Seq2[uint, uint] {
+	return func(yield func(uint, uint) bool) {
+		for _, r := range s.runs {
+			if !yield(r.first, r.last) {
+				return
+			}
+		}
+	}
+}
+
+// NextSet attempts to return the lowest value higher than or equal
+// to i and reports whether it succeeded.
+//
+// Time O(log(r)) and space O(1), where r is the number of runs.
+func (s *RunSet) NextSet(i uint) (uint, bool) {
+	j := s.search(i)
+	if j == len(s.runs) {
+		return 0, false
+	}
+
+	return max(i, s.runs[j].first), true
+}
+
+// AddRange adds every value in range [lo, hi) to RunSet.
+//
+// Time O(r) and space O(1), where r is the number of runs.
+func (s *RunSet) AddRange(lo, hi uint) {
+	if lo < hi {
+		s.addRun(run{lo, hi - 1})
+	}
+}
+
+// RemoveRange removes every value in range [lo, hi) from RunSet.
+//
+// Time O(r) and space O(1), where r is the number of runs.
+func (s *RunSet) RemoveRange(lo, hi uint) {
+	if lo < hi {
+		s.removeRun(run{lo, hi - 1})
+	}
+}
+
+// Complement flips every value in range [lo, hi), adding the ones
+// that do not happen in RunSet and removing the ones that do.
+//
+// Time O(r) and space O(r), where r is the number of runs.
+func (s *RunSet) Complement(lo, hi uint) {
+	if lo >= hi {
+		return
+	}
+
+	// the gaps between runs inside the range become the new runs
+	var gaps []run
+	next := lo
+	for _, r := range s.runs[s.search(lo):] {
+		if r.first >= hi {
+			break
+		}
+		if r.first > next {
+			gaps = append(gaps, run{next, r.first - 1})
+		}
+		if r.last >= hi-1 {
+			next = hi
+			break
+		}
+		next = r.last + 1
+	}
+	if next < hi {
+		gaps = append(gaps, run{next, hi - 1})
+	}
+
+	s.removeRun(run{lo, hi - 1})
+
+	if len(gaps) == 0 {
+		return
+	}
+
+	i := s.search(lo)
+	s.replace(i, i, gaps...)
+
+	// the outer gaps may touch the runs around the range
+	s.join(i + len(gaps) - 1)
+	s.join(i - 1)
+}
+
+// addRun adds every value of added to RunSet.
+func (s *RunSet) addRun(added run) {
+	// runs[i:j] overlap or touch added and merge into it
+	i := s.search(added.first)
+	if i > 0 && s.runs[i-1].last+1 == added.first {
+		i--
+	}
+	j := i
+	for j < len(s.runs) && (s.runs[j].first <= added.last || s.runs[j].first-1 == added.last) {
+		j++
+	}
+
+	if i < j {
+		added.first = min(added.first, s.runs[i].first)
+		added.last = max(added.last, s.runs[j-1].last)
+	}
+
+	s.replace(i, j, added)
+}
+
+// removeRun removes every value of removed from RunSet.
+func (s *RunSet) removeRun(removed run) {
+	// runs[i:j] overlap removed, and only
+	// their parts outside of it are kept
+	i := s.search(removed.first)
+	j := i
+	for j < len(s.runs) && s.runs[j].first <= removed.last {
+		j++
+	}
+	if i == j {
+		return
+	}
+
+	var kept []run
+	if s.runs[i].first < removed.first {
+		kept = append(kept, run{s.runs[i].first, removed.first - 1})
+	}
+	if s.runs[j-1].last > removed.last {
+		kept = append(kept, run{removed.last + 1, s.runs[j-1].last})
+	}
+
+	s.replace(i, j, kept...)
+}
+
+// search returns the index of the first run that ends at
+// or after value, which holds value if any run does.
+func (s *RunSet) search(value uint) int {
+	i, _ := slices.BinarySearchFunc(s.runs, value, func(r run, value uint) int {
+		if r.last < value {
+			return -1
+		}
+		return 1
+	})
+
+	return i
+}
+
+// replace replaces runs[i:j] with runs, updating RunSet's length.
+func (s *RunSet) replace(i, j int, runs ...run) {
+	for _, r := range s.runs[i:j] {
+		s.count -= r.last - r.first + 1
+	}
+	for _, r := range runs {
+		s.count += r.last - r.first + 1
+	}
+
+	s.runs = slices.Replace(s.runs, i, j, runs...)
+}
+
+// join merges runs[i] with runs[i+1] if they touch.
+func (s *RunSet) join(i int) {
+	if i < 0 || i+1 >= len(s.runs) || s.runs[i].last+1 != s.runs[i+1].first {
+		return
+	}
+
+	s.runs[i].last = s.runs[i+1].last
+	s.runs = slices.Delete(s.runs, i+1, i+2)
+}
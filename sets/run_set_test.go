@@ -0,0 +1,183 @@
+package sets
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"testing"
+)
+
+// checkRuns returns an error if RunSet's runs are not sorted,
+// overlap or touch, or do not add up to its length.
+func checkRuns(s *RunSet) error {
+	var n uint
+	for i, r := range s.runs {
+		if r.first > r.last {
+			return fmt.Errorf("run %d is empty: %v", i, r)
+		}
+		if i > 0 && s.runs[i-1].last+1 >= r.first {
+			return fmt.Errorf("run %d %v overlaps or touches run %d %v", i-1, s.runs[i-1], i, r)
+		}
+		n += r.last - r.first + 1
+	}
+
+	if n != s.count {
+		return fmt.Errorf("runs hold %d values, want %d", n, s.count)
+	}
+
+	return nil
+}
+
+func TestRunSet(t *testing.T) {
+	s := &RunSet{}
+
+	for _, v := range []uint{5, 3, 4, 10, math.MaxUint} {
+		if !s.Add(v) {
+			t.Errorf("Add(%d) = false, want true", v)
+		}
+	}
+	if s.Add(4) {
+		t.Errorf("Add(4) = true for a present value, want false")
+	}
+
+	if got, want := slices.Collect(s.Values()), []uint{3, 4, 5, 10, math.MaxUint}; !slices.Equal(got, want) {
+		t.Errorf("Values() = %v, want %v", got, want)
+	}
+	if got, want := s.runs, []run{{3, 5}, {10, 10}, {math.MaxUint, math.MaxUint}}; !slices.Equal(got, want) {
+		t.Errorf("runs = %v, want %v", got, want)
+	}
+
+	if !s.Remove(4) || s.Remove(4) {
+		t.Errorf("Remove(4) reports wrong results")
+	}
+	if got, want := s.runs, []run{{3, 3}, {5, 5}, {10, 10}, {math.MaxUint, math.MaxUint}}; !slices.Equal(got, want) {
+		t.Errorf("runs = %v, want %v", got, want)
+	}
+
+	if v, ok := s.NextSet(6); v != 10 || !ok {
+		t.Errorf("NextSet(6) = (%d, %v), want (10, true)", v, ok)
+	}
+
+	s.AddRange(1_000_000, 2_000_000)
+	if got := s.Len(); got != 1_000_004 {
+		t.Errorf("Len() = %d, want 1000004", got)
+	}
+	if err := checkRuns(s); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRunSetLenOverflow(t *testing.T) {
+	s := &RunSet{}
+
+	s.AddRange(0, 1<<62)
+	if got := s.Len(); got != 1<<62 {
+		t.Errorf("Len() = %d, want %d", got, 1<<62)
+	}
+
+	s.AddRange(0, math.MaxUint)
+	if got := s.Len(); got != math.MaxInt {
+		t.Errorf("Len() = %d after AddRange(0, MaxUint), want MaxInt", got)
+	}
+
+	// every uint, 2^64 values
+	s.Add(math.MaxUint)
+	if got := s.Len(); got != math.MaxInt {
+		t.Errorf("Len() = %d for a full RunSet, want MaxInt", got)
+	}
+
+	s.RemoveRange(1, math.MaxUint)
+	if got := s.Len(); got != 2 {
+		t.Errorf("Len() = %d after RemoveRange(1, MaxUint), want 2", got)
+	}
+
+	s.Complement(0, math.MaxUint)
+	if got := s.Len(); got != math.MaxInt {
+		t.Errorf("Len() = %d after Complement(0, MaxUint), want MaxInt", got)
+	}
+	if err := checkRuns(s); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRunSetComplement(t *testing.T) {
+	tests := []struct {
+		runs   []run
+		lo, hi uint
+		want   []run
+	}{
+		{nil, 0, 3, []run{{0, 2}}},
+		{[]run{{2, 4}}, 0, 10, []run{{0, 1}, {5, 9}}},
+		{[]run{{0, 1}, {5, 9}}, 2, 5, []run{{0, 9}}},
+		{[]run{{0, 9}}, 2, 5, []run{{0, 1}, {5, 9}}},
+		{[]run{{3, 3}, {6, 6}}, 4, 6, []run{{3, 6}}},
+		{[]run{{3, 8}}, 1, 1, []run{{3, 8}}},
+	}
+
+	for i, test := range tests {
+		s := &RunSet{}
+		for _, r := range test.runs {
+			s.AddRange(r.first, r.last+1)
+		}
+
+		s.Complement(test.lo, test.hi)
+
+		if !slices.Equal(s.runs, test.want) {
+			t.Errorf("%d: %v.Complement(%d, %d) = %v, want %v", i, test.runs, test.lo, test.hi, s.runs, test.want)
+		}
+		if err := checkRuns(s); err != nil {
+			t.Errorf("%d: %v", i, err)
+		}
+	}
+}
+
+// FuzzRunSet applies operation sequences decoded from the fuzzer
+// input to a RunSet and a BitSet, checking RunSet's runs and
+// comparing both after every operation.
+func FuzzRunSet(f *testing.F) {
+	f.Add([]byte{0, 1, 0, 2, 0, 3, 1, 2, 2, 10, 3, 5, 4, 20})
+	f.Add([]byte{2, 50, 4, 90, 3, 17, 1, 40, 0, 40, 4, 3})
+
+	f.Fuzz(func(t *testing.T, ops []byte) {
+		s, want := &RunSet{}, &BitSet{}
+
+		for i := 0; i+2 < len(ops); i += 3 {
+			op, lo, hi := ops[i]%5, uint(ops[i+1]), uint(ops[i+1])+uint(ops[i+2]%16)
+
+			switch op {
+			case 0:
+				if got := s.Add(lo); got != want.Add(lo) {
+					t.Fatalf("Add(%d) = %v", lo, got)
+				}
+			case 1:
+				if got := s.Remove(lo); got != want.Remove(lo) {
+					t.Fatalf("Remove(%d) = %v", lo, got)
+				}
+			case 2:
+				s.AddRange(lo, hi)
+				for v := lo; v < hi; v++ {
+					want.Add(v)
+				}
+			case 3:
+				s.RemoveRange(lo, hi)
+				for v := lo; v < hi; v++ {
+					want.Remove(v)
+				}
+			case 4:
+				s.Complement(lo, hi)
+				want.Complement(lo, hi)
+			}
+
+			if err := checkRuns(s); err != nil {
+				t.Fatal(err)
+			}
+			if got, want := slices.Collect(s.Values()), slices.Collect(want.Values()); !slices.Equal(got, want) {
+				t.Fatalf("Values() = %v, want %v", got, want)
+			}
+			v, ok := s.NextSet(lo)
+			if wantV, wantOk := want.NextSet(lo); v != wantV || ok != wantOk {
+				t.Fatalf("NextSet(%d) = (%d, %v), want (%d, %v)", lo, v, ok, wantV, wantOk)
+			}
+		}
+	})
+}
@@ -1,8 +1,17 @@
 // Package sets defines the Set interface and all of it's implementations.
 // A Set is defined as an abstract data structure that prevents duplicate values.
+//
+// The set operations [Union], [Intersection], [Difference] and
+// [SymmetricDifference] return a [HashSet], except when both operands
+// share a type with a faster path: OrderedArraySets are combined by a
+// linear merge into an [OrderedArraySet], and BitSets word by word
+// into a [BitSet].
 package sets
 
-import "iter"
+import (
+	"cmp"
+	"iter"
+)
 
 // Set is the interface for set implementations.
 // A Set is an abstract data structure that prevents duplicate values
@@ -19,3 +28,37 @@ type Set[T comparable] interface {
 	// Values returns an iterator over Set elements.
 	Values() iter.Seq[T]
 }
+
+// OrderedSet is the interface for ordered set implementations.
+// An OrderedSet is a [Set] that keeps its values in ascending order
+// and answers queries about that order.
+type OrderedSet[T cmp.Ordered] interface {
+	Set[T]
+	// Min attempts to return the lowest value and reports whether it succeeded.
+	Min() (T, bool)
+	// Max attempts to return the highest value and reports whether it succeeded.
+	Max() (T, bool)
+	// Floor attempts to return the highest value lower than or equal
+	// to value and reports whether it succeeded.
+	Floor(value T) (T, bool)
+	// Ceiling attempts to return the lowest value higher than or equal
+	// to value and reports whether it succeeded.
+	Ceiling(value T) (T, bool)
+	// Lower attempts to return the highest value lower than value
+	// and reports whether it succeeded.
+	Lower(value T) (T, bool)
+	// Higher attempts to return the lowest value higher than value
+	// and reports whether it succeeded.
+	Higher(value T) (T, bool)
+	// Rank returns the number of values lower than value.
+	Rank(value T) int
+	// Select returns the value at the provided index in ascending order.
+	// It panics if index is out of bounds.
+	Select(index int) T
+	// Range returns an iterator over values in range [lo, hi)
+	// in ascending order.
+	Range(lo, hi T) iter.Seq[T]
+	// Backward returns an iterator over OrderedSet elements
+	// in descending order.
+	Backward() iter.Seq[T]
+}
@@ -0,0 +1,147 @@
+package sets
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		if e := recover(); e != nil {
+			panicked = true
+		}
+	}()
+
+	fn()
+
+	return panicked
+}
+
+// testOrderedSet runs the conformance suite every [OrderedSet]
+// implementation must pass. newSet must return an empty OrderedSet.
+func testOrderedSet(t *testing.T, newSet func() OrderedSet[int]) {
+	t.Helper()
+
+	fill := func(values ...int) OrderedSet[int] {
+		s := newSet()
+		for _, v := range values {
+			s.Add(v)
+		}
+		return s
+	}
+
+	type result struct {
+		value int
+		ok    bool
+	}
+
+	t.Run("Min Max", func(t *testing.T) {
+		tests := []struct {
+			values  []int
+			wantMin result
+			wantMax result
+		}{
+			{nil, result{0, false}, result{0, false}},
+			{[]int{3}, result{3, true}, result{3, true}},
+			{[]int{5, 1, 3}, result{1, true}, result{5, true}},
+		}
+
+		for i, test := range tests {
+			s := fill(test.values...)
+
+			if v, ok := s.Min(); (result{v, ok}) != test.wantMin {
+				t.Errorf("%d: %v.Min() = (%v, %v), want %v", i, test.values, v, ok, test.wantMin)
+			}
+			if v, ok := s.Max(); (result{v, ok}) != test.wantMax {
+				t.Errorf("%d: %v.Max() = (%v, %v), want %v", i, test.values, v, ok, test.wantMax)
+			}
+		}
+	})
+
+	t.Run("neighbors", func(t *testing.T) {
+		s := fill(10, 20, 30)
+
+		tests := []struct {
+			value       int
+			wantFloor   result
+			wantCeiling result
+			wantLower   result
+			wantHigher  result
+			wantRank    int
+		}{
+			{5, result{0, false}, result{10, true}, result{0, false}, result{10, true}, 0},
+			{10, result{10, true}, result{10, true}, result{0, false}, result{20, true}, 0},
+			{15, result{10, true}, result{20, true}, result{10, true}, result{20, true}, 1},
+			{20, result{20, true}, result{20, true}, result{10, true}, result{30, true}, 1},
+			{30, result{30, true}, result{30, true}, result{20, true}, result{0, false}, 2},
+			{35, result{30, true}, result{0, false}, result{30, true}, result{0, false}, 3},
+		}
+
+		for i, test := range tests {
+			if v, ok := s.Floor(test.value); (result{v, ok}) != test.wantFloor {
+				t.Errorf("%d: Floor(%d) = (%v, %v), want %v", i, test.value, v, ok, test.wantFloor)
+			}
+			if v, ok := s.Ceiling(test.value); (result{v, ok}) != test.wantCeiling {
+				t.Errorf("%d: Ceiling(%d) = (%v, %v), want %v", i, test.value, v, ok, test.wantCeiling)
+			}
+			if v, ok := s.Lower(test.value); (result{v, ok}) != test.wantLower {
+				t.Errorf("%d: Lower(%d) = (%v, %v), want %v", i, test.value, v, ok, test.wantLower)
+			}
+			if v, ok := s.Higher(test.value); (result{v, ok}) != test.wantHigher {
+				t.Errorf("%d: Higher(%d) = (%v, %v), want %v", i, test.value, v, ok, test.wantHigher)
+			}
+			if got := s.Rank(test.value); got != test.wantRank {
+				t.Errorf("%d: Rank(%d) = %d, want %d", i, test.value, got, test.wantRank)
+			}
+		}
+	})
+
+	t.Run("Select", func(t *testing.T) {
+		s := fill(30, 10, 20)
+
+		for i, want := range []int{10, 20, 30} {
+			if got := s.Select(i); got != want {
+				t.Errorf("Select(%d) = %d, want %d", i, got, want)
+			}
+		}
+
+		for _, index := range []int{-1, 3} {
+			if !panics(func() { s.Select(index) }) {
+				t.Errorf("Select(%d) expected to panic", index)
+			}
+		}
+	})
+
+	t.Run("Range", func(t *testing.T) {
+		s := fill(1, 2, 3, 4, 5)
+
+		tests := []struct {
+			lo, hi int
+			want   []int
+		}{
+			{0, 10, []int{1, 2, 3, 4, 5}},
+			{2, 4, []int{2, 3}},
+			{3, 3, nil},
+			{4, 2, nil},
+			{6, 10, nil},
+		}
+
+		for i, test := range tests {
+			if got := slices.Collect(s.Range(test.lo, test.hi)); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("%d: Range(%d, %d) = %v, want %v", i, test.lo, test.hi, got, test.want)
+			}
+		}
+	})
+
+	t.Run("Values Backward", func(t *testing.T) {
+		s := fill(3, 1, 2)
+
+		if got, want := slices.Collect(s.Values()), []int{1, 2, 3}; !reflect.DeepEqual(got, want) {
+			t.Errorf("Values() = %v, want %v", got, want)
+		}
+		if got, want := slices.Collect(s.Backward()), []int{3, 2, 1}; !reflect.DeepEqual(got, want) {
+			t.Errorf("Backward() = %v, want %v", got, want)
+		}
+	})
+}
@@ -0,0 +1,398 @@
+package sets
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+)
+
+// avlTree is an AVL tree, a self-balancing binary search tree where
+// sibling subtree heights differ by at most one, augmented with
+// subtree sizes for rank queries. It backs TreeSet and TreeMap.
+//
+// Keys are only compared with [cmp.Compare], which orders NaN before
+// every other float, so NaN keys are found by every query.
+type avlTree[K cmp.Ordered, V any] struct {
+	root *avlNode[K, V]
+}
+
+type avlNode[K cmp.Ordered, V any] struct {
+	key    K
+	value  V
+	left   *avlNode[K, V]
+	right  *avlNode[K, V]
+	height int
+	size   int
+}
+
+func nodeHeight[K cmp.Ordered, V any](n *avlNode[K, V]) int {
+	if n == nil {
+		return 0
+	}
+
+	return n.height
+}
+
+func nodeSize[K cmp.Ordered, V any](n *avlNode[K, V]) int {
+	if n == nil {
+		return 0
+	}
+
+	return n.size
+}
+
+// update recomputes n's height and size from its children.
+func (n *avlNode[K, V]) update() {
+	n.height = max(nodeHeight(n.left), nodeHeight(n.right)) + 1
+	n.size = nodeSize(n.left) + nodeSize(n.right) + 1
+}
+
+func (n *avlNode[K, V]) balanceFactor() int {
+	return nodeHeight(n.left) - nodeHeight(n.right)
+}
+
+func rotateRight[K cmp.Ordered, V any](n *avlNode[K, V]) *avlNode[K, V] {
+	l := n.left
+	n.left = l.right
+	l.right = n
+
+	n.update()
+	l.update()
+
+	return l
+}
+
+func rotateLeft[K cmp.Ordered, V any](n *avlNode[K, V]) *avlNode[K, V] {
+	r := n.right
+	n.right = r.left
+	r.left = n
+
+	n.update()
+	r.update()
+
+	return r
+}
+
+// rebalance restores the AVL property at n, whose children are
+// balanced, and returns the new subtree root.
+func rebalance[K cmp.Ordered, V any](n *avlNode[K, V]) *avlNode[K, V] {
+	n.update()
+
+	switch bf := n.balanceFactor(); {
+	case bf > 1:
+		if n.left.balanceFactor() < 0 {
+			n.left = rotateLeft(n.left)
+		}
+		return rotateRight(n)
+	case bf < -1:
+		if n.right.balanceFactor() > 0 {
+			n.right = rotateRight(n.right)
+		}
+		return rotateLeft(n)
+	}
+
+	return n
+}
+
+func (t *avlTree[K, V]) len() int {
+	return nodeSize(t.root)
+}
+
+func (t *avlTree[K, V]) get(key K) *avlNode[K, V] {
+	n := t.root
+	for n != nil {
+		switch c := cmp.Compare(key, n.key); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return n
+		}
+	}
+
+	return nil
+}
+
+// put associates value with key and reports whether key is new.
+func (t *avlTree[K, V]) put(key K, value V) bool {
+	var inserted bool
+	t.root, inserted = avlPut(t.root, key, value)
+
+	return inserted
+}
+
+func avlPut[K cmp.Ordered, V any](n *avlNode[K, V], key K, value V) (*avlNode[K, V], bool) {
+	if n == nil {
+		return &avlNode[K, V]{key: key, value: value, height: 1, size: 1}, true
+	}
+
+	var inserted bool
+	switch c := cmp.Compare(key, n.key); {
+	case c < 0:
+		n.left, inserted = avlPut(n.left, key, value)
+	case c > 0:
+		n.right, inserted = avlPut(n.right, key, value)
+	default:
+		n.value = value
+		return n, false
+	}
+
+	return rebalance(n), inserted
+}
+
+// remove removes key and reports whether it was found.
+func (t *avlTree[K, V]) remove(key K) bool {
+	var removed bool
+	t.root, removed = avlRemove(t.root, key)
+
+	return removed
+}
+
+func avlRemove[K cmp.Ordered, V any](n *avlNode[K, V], key K) (*avlNode[K, V], bool) {
+	if n == nil {
+		return nil, false
+	}
+
+	var removed bool
+	switch c := cmp.Compare(key, n.key); {
+	case c < 0:
+		n.left, removed = avlRemove(n.left, key)
+	case c > 0:
+		n.right, removed = avlRemove(n.right, key)
+	default:
+		if n.left == nil {
+			return n.right, true
+		}
+		if n.right == nil {
+			return n.left, true
+		}
+
+		// replace n with its successor, the minimum of the right subtree
+		successor := n.right
+		for successor.left != nil {
+			successor = successor.left
+		}
+
+		n.right, _ = avlRemove(n.right, successor.key)
+		successor.left, successor.right = n.left, n.right
+		n = successor
+		removed = true
+	}
+
+	return rebalance(n), removed
+}
+
+func (t *avlTree[K, V]) min() *avlNode[K, V] {
+	n := t.root
+	for n != nil && n.left != nil {
+		n = n.left
+	}
+
+	return n
+}
+
+func (t *avlTree[K, V]) max() *avlNode[K, V] {
+	n := t.root
+	for n != nil && n.right != nil {
+		n = n.right
+	}
+
+	return n
+}
+
+// floor returns the node with the highest key lower than key,
+// or also equal to key if inclusive, or nil.
+func (t *avlTree[K, V]) floor(key K, inclusive bool) *avlNode[K, V] {
+	var found *avlNode[K, V]
+
+	for n := t.root; n != nil; {
+		if c := cmp.Compare(n.key, key); c < 0 || inclusive && c == 0 {
+			found = n
+			n = n.right
+		} else {
+			n = n.left
+		}
+	}
+
+	return found
+}
+
+// ceiling returns the node with the lowest key higher than key,
+// or also equal to key if inclusive, or nil.
+func (t *avlTree[K, V]) ceiling(key K, inclusive bool) *avlNode[K, V] {
+	var found *avlNode[K, V]
+
+	for n := t.root; n != nil; {
+		if c := cmp.Compare(n.key, key); c > 0 || inclusive && c == 0 {
+			found = n
+			n = n.left
+		} else {
+			n = n.right
+		}
+	}
+
+	return found
+}
+
+// rank returns the number of keys lower than key.
+func (t *avlTree[K, V]) rank(key K) int {
+	rank := 0
+
+	for n := t.root; n != nil; {
+		if cmp.Less(n.key, key) {
+			rank += nodeSize(n.left) + 1
+			n = n.right
+		} else {
+			n = n.left
+		}
+	}
+
+	return rank
+}
+
+// at returns the node at index in key order.
+// It panics if index is out of bounds.
+func (t *avlTree[K, V]) at(index int) *avlNode[K, V] {
+	if index < 0 || index >= t.len() {
+		panic(fmt.Sprintf("index out of range [%d] with length %d", index, t.len()))
+	}
+
+	n := t.root
+	for {
+		switch leftSize := nodeSize(n.left); {
+		case index < leftSize:
+			n = n.left
+		case index > leftSize:
+			index -= leftSize + 1
+			n = n.right
+		default:
+			return n
+		}
+	}
+}
+
+// ascend returns an iterator over nodes with keys in range [lo, hi)
+// in ascending order, with unbounded ends when hasLo or hasHi is false.
+func (t *avlTree[K, V]) ascend(lo, hi K, hasLo, hasHi bool) iter.Seq[*avlNode[K, V]] {
+	var walk func(n *avlNode[K, V], yield func(*avlNode[K, V]) bool) bool
+	walk = func(n *avlNode[K, V], yield func(*avlNode[K, V]) bool) bool {
+		if n == nil {
+			return true
+		}
+
+		aboveLo := !hasLo || cmp.Compare(n.key, lo) >= 0
+		belowHi := !hasHi || cmp.Less(n.key, hi)
+
+		if aboveLo && !walk(n.left, yield) {
+			return false
+		}
+		if aboveLo && belowHi && !yield(n) {
+			return false
+		}
+		if belowHi {
+			return walk(n.right, yield)
+		}
+
+		return true
+	}
+
+	return func(yield func(*avlNode[K, V]) bool) {
+		walk(t.root, yield)
+	}
+}
+
+// descend returns an iterator over nodes in descending order.
+func (t *avlTree[K, V]) descend() iter.Seq[*avlNode[K, V]] {
+	var walk func(n *avlNode[K, V], yield func(*avlNode[K, V]) bool) bool
+	walk = func(n *avlNode[K, V], yield func(*avlNode[K, V]) bool) bool {
+		return n == nil || walk(n.right, yield) && yield(n) && walk(n.left, yield)
+	}
+
+	return func(yield func(*avlNode[K, V]) bool) {
+		walk(t.root, yield)
+	}
+}
+
+// check verifies the tree's invariants: keys in search order,
+// cached heights and sizes, and AVL balance.
+func (t *avlTree[K, V]) check() error {
+	var walk func(n *avlNode[K, V], lo, hi *K) error
+	walk = func(n *avlNode[K, V], lo, hi *K) error {
+		if n == nil {
+			return nil
+		}
+
+		if lo != nil && cmp.Compare(n.key, *lo) <= 0 || hi != nil && cmp.Compare(n.key, *hi) >= 0 {
+			return fmt.Errorf("sets: key %v out of search order", n.key)
+		}
+
+		if err := walk(n.left, lo, &n.key); err != nil {
+			return err
+		}
+		if err := walk(n.right, &n.key, hi); err != nil {
+			return err
+		}
+
+		if want := max(nodeHeight(n.left), nodeHeight(n.right)) + 1; n.height != want {
+			return fmt.Errorf("sets: key %v has height %d, want %d", n.key, n.height, want)
+		}
+		if want := nodeSize(n.left) + nodeSize(n.right) + 1; n.size != want {
+			return fmt.Errorf("sets: key %v has size %d, want %d", n.key, n.size, want)
+		}
+		if bf := n.balanceFactor(); bf < -1 || bf > 1 {
+			return fmt.Errorf("sets: key %v has balance factor %d", n.key, bf)
+		}
+
+		return nil
+	}
+
+	return walk(t.root, nil, nil)
+}
+
+// nodeKey attempts to return n's key and reports whether n is not nil.
+func nodeKey[K cmp.Ordered, V any](n *avlNode[K, V]) (K, bool) {
+	if n == nil {
+		var k K
+		return k, false
+	}
+
+	return n.key, true
+}
+
+// nodeKeys maps an iterator over nodes to an iterator over their keys.
+func nodeKeys[K cmp.Ordered, V any](nodes iter.Seq[*avlNode[K, V]]) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for n := range nodes {
+			if !yield(n.key) {
+				return
+			}
+		}
+	}
+}
+
+// nodeEntry attempts to return n's key and value
+// and reports whether n is not nil.
+func nodeEntry[K cmp.Ordered, V any](n *avlNode[K, V]) (K, V, bool) {
+	if n == nil {
+		var (
+			k K
+			v V
+		)
+		return k, v, false
+	}
+
+	return n.key, n.value, true
+}
+
+// nodePairs maps an iterator over nodes to an iterator
+// over their key-value pairs.
+func nodePairs[K cmp.Ordered, V any](nodes iter.Seq[*avlNode[K, V]]) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for n := range nodes {
+			if !yield(n.key, n.value) {
+				return
+			}
+		}
+	}
+}
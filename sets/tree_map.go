@@ -0,0 +1,173 @@
+package sets
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"strings"
+)
+
+// TreeMap is an ordered map that uses the same AVL tree as [TreeSet]
+// underneath, associating a value with each key.
+//
+// The zero value is an empty TreeMap ready to use.
+type TreeMap[K cmp.Ordered, V any] struct {
+	tree avlTree[K, V]
+}
+
+// Get returns the value associated with key and reports whether it was found.
+//
+// Time O(log(n)) and space O(1).
+func (m *TreeMap[K, V]) Get(key K) (V, bool) {
+	if n := m.tree.get(key); n != nil {
+		return n.value, true
+	}
+
+	var v V
+	return v, false
+}
+
+// Has reports whether key happens in TreeMap.
+//
+// Time O(log(n)) and space O(1).
+func (m *TreeMap[K, V]) Has(key K) bool {
+	return m.tree.get(key) != nil
+}
+
+// Put associates value with key and reports whether key is new.
+// If key already exists, its value is replaced.
+//
+// Time O(log(n)) and space O(log(n)).
+func (m *TreeMap[K, V]) Put(key K, value V) bool {
+	return m.tree.put(key, value)
+}
+
+// Delete removes key and reports whether it was found.
+//
+// Time O(log(n)) and space O(log(n)).
+func (m *TreeMap[K, V]) Delete(key K) bool {
+	return m.tree.remove(key)
+}
+
+// Len returns TreeMap's length.
+func (m *TreeMap[K, V]) Len() int {
+	return m.tree.len()
+}
+
+// Min attempts to return the key-value pair with the lowest key
+// and reports whether it succeeded.
+//
+// Time O(log(n)) and space O(1).
+func (m *TreeMap[K, V]) Min() (K, V, bool) {
+	return nodeEntry(m.tree.min())
+}
+
+// Max attempts to return the key-value pair with the highest key
+// and reports whether it succeeded.
+//
+// Time O(log(n)) and space O(1).
+func (m *TreeMap[K, V]) Max() (K, V, bool) {
+	return nodeEntry(m.tree.max())
+}
+
+// Floor attempts to return the key-value pair with the highest key
+// lower than or equal to key and reports whether it succeeded.
+//
+// Time O(log(n)) and space O(1).
+func (m *TreeMap[K, V]) Floor(key K) (K, V, bool) {
+	return nodeEntry(m.tree.floor(key, true))
+}
+
+// Ceiling attempts to return the key-value pair with the lowest key
+// higher than or equal to key and reports whether it succeeded.
+//
+// Time O(log(n)) and space O(1).
+func (m *TreeMap[K, V]) Ceiling(key K) (K, V, bool) {
+	return nodeEntry(m.tree.ceiling(key, true))
+}
+
+// Lower attempts to return the key-value pair with the highest key
+// lower than key and reports whether it succeeded.
+//
+// Time O(log(n)) and space O(1).
+func (m *TreeMap[K, V]) Lower(key K) (K, V, bool) {
+	return nodeEntry(m.tree.floor(key, false))
+}
+
+// Higher attempts to return the key-value pair with the lowest key
+// higher than key and reports whether it succeeded.
+//
+// Time O(log(n)) and space O(1).
+func (m *TreeMap[K, V]) Higher(key K) (K, V, bool) {
+	return nodeEntry(m.tree.ceiling(key, false))
+}
+
+// Rank returns the number of keys lower than key.
+//
+// Time O(log(n)) and space O(1).
+func (m *TreeMap[K, V]) Rank(key K) int {
+	return m.tree.rank(key)
+}
+
+// Select returns the key-value pair at the provided index in key order.
+// It panics if index is out of bounds.
+//
+// Time O(log(n)) and space O(1).
+func (m *TreeMap[K, V]) Select(index int) (K, V) {
+	n := m.tree.at(index)
+
+	return n.key, n.value
+}
+
+// All returns an iterator over TreeMap's key-value pairs in key order.
+func (m *TreeMap[K, V]) All() iter.Seq2[K, V] {
+	var zero K
+	return nodePairs(m.tree.ascend(zero, zero, false, false))
+}
+
+// Keys returns an iterator over TreeMap's keys in ascending order.
+func (m *TreeMap[K, V]) Keys() iter.Seq[K] {
+	var zero K
+	return nodeKeys(m.tree.ascend(zero, zero, false, false))
+}
+
+// Range returns an iterator over TreeMap's key-value pairs
+// with keys in range [lo, hi) in key order.
+func (m *TreeMap[K, V]) Range(lo, hi K) iter.Seq2[K, V] {
+	return nodePairs(m.tree.ascend(lo, hi, true, true))
+}
+
+// Backward returns an iterator over TreeMap's key-value pairs
+// in descending key order.
+func (m *TreeMap[K, V]) Backward() iter.Seq2[K, V] {
+	return nodePairs(m.tree.descend())
+}
+
+func (m *TreeMap[K, V]) String() string {
+	var builder strings.Builder
+
+	// assume each element requires at least three bytes for printing
+	// and one byte for spacing between elements
+	// len("TreeMap[]") + (TreeMap.len * 4)
+	builder.Grow(9 + (m.Len() * 4))
+
+	builder.WriteString("TreeMap[")
+
+	first := true
+	for k, v := range m.All() {
+		if !first {
+			builder.WriteString(" ")
+		}
+		first = false
+		builder.WriteString(fmt.Sprintf("%v:%v", k, v))
+	}
+
+	builder.WriteString("]")
+
+	return builder.String()
+}
+
+// check verifies TreeMap's tree invariants.
+func (m *TreeMap[K, V]) check() error {
+	return m.tree.check()
+}
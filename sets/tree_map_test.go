@@ -0,0 +1,114 @@
+package sets
+
+import (
+	"maps"
+	"slices"
+	"testing"
+)
+
+func TestTreeMap(t *testing.T) {
+	m := &TreeMap[string, int]{}
+
+	for i, k := range []string{"d", "b", "a", "c", "e"} {
+		if !m.Put(k, i) {
+			t.Errorf("Put(%q) = false, want true", k)
+		}
+	}
+	if m.Put("a", 10) {
+		t.Errorf("Put(%q) = true for a present key, want false", "a")
+	}
+
+	if got, want := m.String(), "TreeMap[a:10 b:1 c:3 d:0 e:4]"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+
+	if v, ok := m.Get("a"); v != 10 || !ok {
+		t.Errorf("Get(%q) = (%d, %v), want (10, true)", "a", v, ok)
+	}
+	if v, ok := m.Get("z"); v != 0 || ok {
+		t.Errorf("Get(%q) = (%d, %v), want (0, false)", "z", v, ok)
+	}
+
+	if k, v, ok := m.Floor("bb"); k != "b" || v != 1 || !ok {
+		t.Errorf("Floor(%q) = (%q, %d, %v), want (\"b\", 1, true)", "bb", k, v, ok)
+	}
+	if k, _, ok := m.Higher("e"); ok {
+		t.Errorf("Higher(%q) = (%q, _, true), want false", "e", k)
+	}
+	if k, v := m.Select(2); k != "c" || v != 3 {
+		t.Errorf("Select(2) = (%q, %d), want (\"c\", 3)", k, v)
+	}
+	if got := m.Rank("c"); got != 2 {
+		t.Errorf("Rank(%q) = %d, want 2", "c", got)
+	}
+
+	if got, want := maps.Collect(m.Range("b", "d")), map[string]int{"b": 1, "c": 3}; !maps.Equal(got, want) {
+		t.Errorf("Range(%q, %q) = %v, want %v", "b", "d", got, want)
+	}
+
+	var backward []string
+	for k := range m.Backward() {
+		backward = append(backward, k)
+	}
+	if want := []string{"e", "d", "c", "b", "a"}; !slices.Equal(backward, want) {
+		t.Errorf("Backward() = %v, want %v", backward, want)
+	}
+
+	if !m.Delete("c") || m.Delete("c") {
+		t.Errorf("Delete(%q) reports wrong result", "c")
+	}
+	if got, want := slices.Collect(m.Keys()), []string{"a", "b", "d", "e"}; !slices.Equal(got, want) {
+		t.Errorf("Keys() = %v, want %v", got, want)
+	}
+	if err := m.check(); err != nil {
+		t.Error(err)
+	}
+}
+
+// FuzzTreeMap applies operation sequences decoded from the fuzzer
+// input to a TreeMap and a map, checking the tree invariants and
+// comparing both after every operation.
+func FuzzTreeMap(f *testing.F) {
+	f.Add([]byte{0, 1, 0, 2, 0, 3, 1, 2, 0, 2, 2, 3})
+	f.Add([]byte{0, 5, 0, 4, 0, 3, 0, 2, 0, 1, 1, 4, 1, 5, 2, 1})
+
+	f.Fuzz(func(t *testing.T, ops []byte) {
+		m := &TreeMap[int, int]{}
+		want := map[int]int{}
+
+		for i := 0; i+1 < len(ops); i += 2 {
+			op, key := ops[i]%3, int(ops[i+1])%32
+
+			switch op {
+			case 0:
+				_, present := want[key]
+				if got := m.Put(key, i); got == present {
+					t.Fatalf("Put(%d) = %v", key, got)
+				}
+				want[key] = i
+			case 1:
+				_, present := want[key]
+				if got := m.Delete(key); got != present {
+					t.Fatalf("Delete(%d) = %v", key, got)
+				}
+				delete(want, key)
+			case 2:
+				v, present := want[key]
+				if got, ok := m.Get(key); got != v || ok != present {
+					t.Fatalf("Get(%d) = (%d, %v), want (%d, %v)", key, got, ok, v, present)
+				}
+			}
+
+			if err := m.check(); err != nil {
+				t.Fatal(err)
+			}
+			if m.Len() != len(want) {
+				t.Fatalf("Len() = %d, want %d", m.Len(), len(want))
+			}
+		}
+
+		if got := maps.Collect(m.All()); !maps.Equal(got, want) {
+			t.Fatalf("All() = %v, want %v", got, want)
+		}
+	})
+}
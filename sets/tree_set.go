@@ -0,0 +1,129 @@
+package sets
+
+import (
+	"cmp"
+	"iter"
+)
+
+var _ OrderedSet[int] = &TreeSet[int]{}
+
+// TreeSet is an [OrderedSet] implementation that uses an AVL tree,
+// a self-balancing binary search tree, underneath. Each node caches
+// the size of its subtree, so rank queries are logarithmic too.
+//
+// The zero value is an empty TreeSet ready to use.
+type TreeSet[T cmp.Ordered] struct {
+	tree avlTree[T, struct{}]
+}
+
+// Has reports whether value happens in TreeSet.
+//
+// Time O(log(n)) and space O(1).
+func (s *TreeSet[T]) Has(value T) bool {
+	return s.tree.get(value) != nil
+}
+
+// Add adds value to TreeSet and reports whether it succeed.
+//
+// Time O(log(n)) and space O(log(n)).
+func (s *TreeSet[T]) Add(value T) bool {
+	return s.tree.put(value, struct{}{})
+}
+
+// Remove removes value from TreeSet and reports whether it was found.
+//
+// Time O(log(n)) and space O(log(n)).
+func (s *TreeSet[T]) Remove(value T) bool {
+	return s.tree.remove(value)
+}
+
+// Len returns TreeSet's length.
+func (s *TreeSet[T]) Len() int {
+	return s.tree.len()
+}
+
+// Values returns an iterator of TreeSet elements in ascending order.
+func (s *TreeSet[T]) Values() iter.Seq[T] {
+	var zero T
+	return nodeKeys(s.tree.ascend(zero, zero, false, false))
+}
+
+// Min attempts to return TreeSet's lowest value
+// and reports whether it succeeded.
+//
+// Time O(log(n)) and space O(1).
+func (s *TreeSet[T]) Min() (T, bool) {
+	return nodeKey(s.tree.min())
+}
+
+// Max attempts to return TreeSet's highest value
+// and reports whether it succeeded.
+//
+// Time O(log(n)) and space O(1).
+func (s *TreeSet[T]) Max() (T, bool) {
+	return nodeKey(s.tree.max())
+}
+
+// Floor attempts to return the highest value lower than or equal
+// to value and reports whether it succeeded.
+//
+// Time O(log(n)) and space O(1).
+func (s *TreeSet[T]) Floor(value T) (T, bool) {
+	return nodeKey(s.tree.floor(value, true))
+}
+
+// Ceiling attempts to return the lowest value higher than or equal
+// to value and reports whether it succeeded.
+//
+// Time O(log(n)) and space O(1).
+func (s *TreeSet[T]) Ceiling(value T) (T, bool) {
+	return nodeKey(s.tree.ceiling(value, true))
+}
+
+// Lower attempts to return the highest value lower than value
+// and reports whether it succeeded.
+//
+// Time O(log(n)) and space O(1).
+func (s *TreeSet[T]) Lower(value T) (T, bool) {
+	return nodeKey(s.tree.floor(value, false))
+}
+
+// Higher attempts to return the lowest value higher than value
+// and reports whether it succeeded.
+//
+// Time O(log(n)) and space O(1).
+func (s *TreeSet[T]) Higher(value T) (T, bool) {
+	return nodeKey(s.tree.ceiling(value, false))
+}
+
+// Rank returns the number of values lower than value.
+//
+// Time O(log(n)) and space O(1).
+func (s *TreeSet[T]) Rank(value T) int {
+	return s.tree.rank(value)
+}
+
+// Select returns the value at the provided index in ascending order.
+// It panics if index is out of bounds.
+//
+// Time O(log(n)) and space O(1).
+func (s *TreeSet[T]) Select(index int) T {
+	return s.tree.at(index).key
+}
+
+// Range returns an iterator over TreeSet elements
+// in range [lo, hi) in ascending order.
+func (s *TreeSet[T]) Range(lo, hi T) iter.Seq[T] {
+	return nodeKeys(s.tree.ascend(lo, hi, true, true))
+}
+
+// Backward returns an iterator of TreeSet elements
+// in descending order.
+func (s *TreeSet[T]) Backward() iter.Seq[T] {
+	return nodeKeys(s.tree.descend())
+}
+
+// check verifies TreeSet's tree invariants.
+func (s *TreeSet[T]) check() error {
+	return s.tree.check()
+}
@@ -0,0 +1,195 @@
+package sets
+
+import (
+	"math"
+	"math/bits"
+	"slices"
+	"testing"
+)
+
+func TestTreeSetOrdered(t *testing.T) {
+	testOrderedSet(t, func() OrderedSet[int] { return &TreeSet[int]{} })
+}
+
+func TestTreeSetAddRemove(t *testing.T) {
+	s := &TreeSet[string]{}
+
+	for _, v := range []string{"b", "a", "c"} {
+		if !s.Add(v) {
+			t.Errorf("Add(%q) = false, want true", v)
+		}
+	}
+	if s.Add("a") {
+		t.Errorf("Add(%q) = true for a present value, want false", "a")
+	}
+	if !s.Has("c") || s.Has("d") {
+		t.Errorf("Has() reports wrong membership for %v", slices.Collect(s.Values()))
+	}
+
+	if !s.Remove("b") {
+		t.Errorf("Remove(%q) = false, want true", "b")
+	}
+	if s.Remove("b") {
+		t.Errorf("Remove(%q) = true for a removed value, want false", "b")
+	}
+
+	if got, want := slices.Collect(s.Values()), []string{"a", "c"}; !slices.Equal(got, want) {
+		t.Errorf("Values() = %v, want %v", got, want)
+	}
+	if got := s.Len(); got != 2 {
+		t.Errorf("Len() = %d, want 2", got)
+	}
+}
+
+func TestTreeSetNaN(t *testing.T) {
+	nan := math.NaN()
+
+	s := &TreeSet[float64]{}
+	for _, v := range []float64{2, nan, 1} {
+		s.Add(v)
+	}
+
+	if err := s.check(); err != nil {
+		t.Fatal(err)
+	}
+
+	if !s.Has(nan) {
+		t.Errorf("Has(NaN) = false, want true")
+	}
+	if v, ok := s.Floor(nan); !math.IsNaN(v) || !ok {
+		t.Errorf("Floor(NaN) = (%v, %v), want (NaN, true)", v, ok)
+	}
+	if v, ok := s.Ceiling(nan); !math.IsNaN(v) || !ok {
+		t.Errorf("Ceiling(NaN) = (%v, %v), want (NaN, true)", v, ok)
+	}
+	if v, ok := s.Higher(nan); v != 1 || !ok {
+		t.Errorf("Higher(NaN) = (%v, %v), want (1, true)", v, ok)
+	}
+	if got := s.Rank(1); got != 1 {
+		t.Errorf("Rank(1) = %d, want 1", got)
+	}
+	if got := slices.Collect(s.Range(nan, 2)); len(got) != 2 || !math.IsNaN(got[0]) || got[1] != 1 {
+		t.Errorf("Range(NaN, 2) = %v, want [NaN 1]", got)
+	}
+}
+
+func TestTreeSetBalance(t *testing.T) {
+	const length = 1 << 12
+
+	s := &TreeSet[int]{}
+
+	// sequential inserts degenerate unbalanced trees into lists
+	for i := range length {
+		s.Add(i)
+	}
+	for i := 0; i < length; i += 3 {
+		s.Remove(i)
+	}
+
+	if err := s.check(); err != nil {
+		t.Fatal(err)
+	}
+
+	// an AVL tree with n nodes is at most 1.44*log2(n+2) high
+	if h, limit := s.tree.root.height, 1.44*float64(bits.Len(uint(s.Len()+2))); float64(h) > limit {
+		t.Errorf("height = %d, want at most %.1f", h, limit)
+	}
+}
+
+func TestTreeSetCheck(t *testing.T) {
+	corrupt := []struct {
+		name string
+		fn   func(s *TreeSet[int])
+	}{
+		{"order", func(s *TreeSet[int]) { s.tree.root.key = -1 }},
+		{"height", func(s *TreeSet[int]) { s.tree.root.height++ }},
+		{"size", func(s *TreeSet[int]) { s.tree.root.left.size++ }},
+		{"balance", func(s *TreeSet[int]) {
+			// detaching a subtree and fixing cached values up
+			// leaves the root balance factor at 2
+			s.tree.root.right = nil
+			s.tree.root.update()
+		}},
+	}
+
+	for _, test := range corrupt {
+		s := &TreeSet[int]{}
+		for i := range 7 {
+			s.Add(i * 2)
+		}
+		s.Add(1)
+
+		if err := s.check(); err != nil {
+			t.Fatalf("%s: check() = %v before corrupting", test.name, err)
+		}
+
+		test.fn(s)
+
+		if err := s.check(); err == nil {
+			t.Errorf("%s: check() = nil after corrupting", test.name)
+		}
+	}
+}
+
+// FuzzTreeSet applies operation sequences decoded from the fuzzer
+// input to a TreeSet and a map, checking the tree invariants and
+// comparing both after every operation.
+func FuzzTreeSet(f *testing.F) {
+	f.Add([]byte{0, 1, 0, 2, 0, 3, 0, 4, 0, 5, 1, 3, 1, 1, 2, 2})
+	f.Add([]byte{0, 9, 0, 8, 0, 7, 0, 6, 1, 8, 0, 8, 3, 5, 4, 7})
+
+	f.Fuzz(func(t *testing.T, ops []byte) {
+		s := &TreeSet[int]{}
+		want := map[int]bool{}
+
+		for i := 0; i+1 < len(ops); i += 2 {
+			op, arg := ops[i]%5, int(ops[i+1])
+
+			switch op {
+			case 0, 1:
+				if got := s.Add(arg); got != !want[arg] {
+					t.Fatalf("Add(%d) = %v", arg, got)
+				}
+				want[arg] = true
+			case 2:
+				if got := s.Remove(arg); got != want[arg] {
+					t.Fatalf("Remove(%d) = %v", arg, got)
+				}
+				delete(want, arg)
+			case 3:
+				if got := s.Has(arg); got != want[arg] {
+					t.Fatalf("Has(%d) = %v", arg, got)
+				}
+			case 4:
+				rank := 0
+				for v := range want {
+					if v < arg {
+						rank++
+					}
+				}
+				if got := s.Rank(arg); got != rank {
+					t.Fatalf("Rank(%d) = %d, want %d", arg, got, rank)
+				}
+			}
+
+			if err := s.check(); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		var values []int
+		for v := range want {
+			values = append(values, v)
+		}
+		slices.Sort(values)
+
+		if got := slices.Collect(s.Values()); !slices.Equal(got, values) {
+			t.Fatalf("Values() = %v, want %v", got, values)
+		}
+		for i, v := range values {
+			if got := s.Select(i); got != v {
+				t.Fatalf("Select(%d) = %d, want %d", i, got, v)
+			}
+		}
+	})
+}
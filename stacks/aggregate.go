@@ -0,0 +1,229 @@
+package stacks
+
+import (
+	"cmp"
+	"fmt"
+)
+
+var (
+	_ Stack[int] = &AggregateStack[int, int]{}
+	_ Stack[int] = &MinMaxStack[int]{}
+)
+
+// AggregateStack is a [Stack] implementation that also answers the
+// aggregate of all of its values in O(1), for any monoid.
+//
+// Each entry stores the aggregate of itself and every value below it,
+// so popping a value restores the previous aggregate for free.
+//
+// An AggregateStack must be created with [NewAggregateStack]. The zero
+// value has no functions to aggregate with, and pushing to it panics.
+type AggregateStack[T, A any] struct {
+	entries  []aggregateEntry[T, A]
+	identity A
+	lift     func(T) A
+	combine  func(A, A) A
+}
+
+type aggregateEntry[T, A any] struct {
+	value     T
+	aggregate A
+}
+
+// NewAggregateStack returns an empty AggregateStack where lift maps
+// each value to the aggregate domain and combine merges aggregates.
+//
+// combine must be associative and identity must be its neutral element,
+// meaning combine(identity, a) == a.
+// Values are combined from bottom to top, as combine(below, above).
+//
+// It panics if lift or combine is nil.
+func NewAggregateStack[T, A any](identity A, lift func(T) A, combine func(A, A) A) *AggregateStack[T, A] {
+	if lift == nil || combine == nil {
+		panic("nil lift or combine function")
+	}
+
+	return &AggregateStack[T, A]{
+		identity: identity,
+		lift:     lift,
+		combine:  combine,
+	}
+}
+
+// Push adds value to the top of AggregateStack.
+// It panics if AggregateStack was not created with [NewAggregateStack].
+//
+// Time O(1) amortized and space O(1).
+func (s *AggregateStack[T, A]) Push(value T) {
+	if s.combine == nil {
+		panic("push to AggregateStack not created with NewAggregateStack")
+	}
+
+	s.entries = append(s.entries, aggregateEntry[T, A]{
+		value:     value,
+		aggregate: s.combine(s.Aggregate(), s.lift(value)),
+	})
+}
+
+// Pop attempts to remove and return the value at the top of
+// AggregateStack and reports whether it succeeded.
+//
+// Time O(1) and space O(1).
+func (s *AggregateStack[T, A]) Pop() (T, bool) {
+	length := len(s.entries)
+	if length == 0 {
+		var v T
+		return v, false
+	}
+
+	value := s.entries[length-1].value
+	s.entries[length-1] = aggregateEntry[T, A]{}
+	s.entries = s.entries[:length-1]
+
+	return value, true
+}
+
+// Peek attempts to return the value at the top of AggregateStack
+// without removing it and reports whether it succeeded.
+//
+// Time O(1) and space O(1).
+func (s *AggregateStack[T, A]) Peek() (T, bool) {
+	length := len(s.entries)
+	if length == 0 {
+		var v T
+		return v, false
+	}
+
+	return s.entries[length-1].value, true
+}
+
+// Aggregate returns the combination of every value in AggregateStack,
+// or the identity if it is empty.
+//
+// Time O(1) and space O(1).
+func (s *AggregateStack[T, A]) Aggregate() A {
+	length := len(s.entries)
+	if length == 0 {
+		return s.identity
+	}
+
+	return s.entries[length-1].aggregate
+}
+
+// Len returns AggregateStack's length.
+func (s *AggregateStack[T, A]) Len() int {
+	return len(s.entries)
+}
+
+// String formats AggregateStack's values from bottom to top,
+// the same way as [StackArray.String].
+func (s *AggregateStack[T, A]) String() string {
+	values := make([]T, len(s.entries))
+	for i, e := range s.entries {
+		values[i] = e.value
+	}
+
+	return fmt.Sprintf("AggregateStack{%v}", values)
+}
+
+// MinMaxStack is a [Stack] implementation that also answers
+// its minimum and maximum values in O(1).
+//
+// The zero value is an empty MinMaxStack ready to use.
+type MinMaxStack[T cmp.Ordered] struct {
+	entries []minMaxEntry[T]
+}
+
+type minMaxEntry[T cmp.Ordered] struct {
+	value, min, max T
+}
+
+// Push adds value to the top of MinMaxStack.
+//
+// Time O(1) amortized and space O(1).
+func (s *MinMaxStack[T]) Push(value T) {
+	entry := minMaxEntry[T]{value, value, value}
+
+	if length := len(s.entries); length != 0 {
+		below := s.entries[length-1]
+		entry.min = min(below.min, value)
+		entry.max = max(below.max, value)
+	}
+
+	s.entries = append(s.entries, entry)
+}
+
+// Pop attempts to remove and return the value at the top of
+// MinMaxStack and reports whether it succeeded.
+//
+// Time O(1) and space O(1).
+func (s *MinMaxStack[T]) Pop() (T, bool) {
+	length := len(s.entries)
+	if length == 0 {
+		var v T
+		return v, false
+	}
+
+	value := s.entries[length-1].value
+	s.entries = s.entries[:length-1]
+
+	return value, true
+}
+
+// Peek attempts to return the value at the top of MinMaxStack
+// without removing it and reports whether it succeeded.
+//
+// Time O(1) and space O(1).
+func (s *MinMaxStack[T]) Peek() (T, bool) {
+	length := len(s.entries)
+	if length == 0 {
+		var v T
+		return v, false
+	}
+
+	return s.entries[length-1].value, true
+}
+
+// Min attempts to return the lowest value in MinMaxStack
+// and reports whether it succeeded.
+//
+// Time O(1) and space O(1).
+func (s *MinMaxStack[T]) Min() (T, bool) {
+	length := len(s.entries)
+	if length == 0 {
+		var v T
+		return v, false
+	}
+
+	return s.entries[length-1].min, true
+}
+
+// Max attempts to return the highest value in MinMaxStack
+// and reports whether it succeeded.
+//
+// Time O(1) and space O(1).
+func (s *MinMaxStack[T]) Max() (T, bool) {
+	length := len(s.entries)
+	if length == 0 {
+		var v T
+		return v, false
+	}
+
+	return s.entries[length-1].max, true
+}
+
+// Len returns MinMaxStack's length.
+func (s *MinMaxStack[T]) Len() int {
+	return len(s.entries)
+}
+
+// String formats MinMaxStack's values from bottom to top,
+// the same way as [StackArray.String].
+func (s *MinMaxStack[T]) String() string {
+	values := make([]T, len(s.entries))
+	for i, e := range s.entries {
+		values[i] = e.value
+	}
+
+	return fmt.Sprintf("MinMaxStack{%v}", values)
+}
@@ -0,0 +1,125 @@
+package stacks
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func sum(a, b int) int {
+	return a + b
+}
+
+func identity(v int) int {
+	return v
+}
+
+func TestAggregateStack(t *testing.T) {
+	testStack(t, func() Stack[int] { return NewAggregateStack(0, identity, sum) })
+
+	t.Run("Aggregate", func(t *testing.T) {
+		s := NewAggregateStack(0, identity, sum)
+
+		if got := s.Aggregate(); got != 0 {
+			t.Errorf("%v.Aggregate() = %d, want 0", s, got)
+		}
+
+		fill(s, 1, 2, 3)
+		if got := s.Aggregate(); got != 6 {
+			t.Errorf("%v.Aggregate() = %d, want 6", s, got)
+		}
+
+		s.Pop()
+		if got := s.Aggregate(); got != 3 {
+			t.Errorf("%v.Aggregate() = %d, want 3", s, got)
+		}
+	})
+
+	t.Run("order", func(t *testing.T) {
+		s := NewAggregateStack("", func(v string) string { return v }, func(a, b string) string { return a + b })
+		s.Push("a")
+		s.Push("b")
+		s.Push("c")
+
+		if got := s.Aggregate(); got != "abc" {
+			t.Errorf("%v.Aggregate() = %q, want %q", s, got, "abc")
+		}
+		if got, want := s.String(), "AggregateStack{[a b c]}"; got != want {
+			t.Errorf("String() = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("zero value", func(t *testing.T) {
+		s := &AggregateStack[int, int]{}
+
+		if !panics(func() { s.Push(1) }) {
+			t.Errorf("Push() to a zero value AggregateStack expected to panic")
+		}
+		if _, ok := s.Pop(); ok {
+			t.Errorf("Pop() on a zero value AggregateStack = (_, true), want false")
+		}
+	})
+
+	t.Run("nil functions", func(t *testing.T) {
+		if !panics(func() { NewAggregateStack[int](0, nil, sum) }) {
+			t.Errorf("NewAggregateStack() with a nil lift expected to panic")
+		}
+		if !panics(func() { NewAggregateStack(0, identity, nil) }) {
+			t.Errorf("NewAggregateStack() with a nil combine expected to panic")
+		}
+	})
+}
+
+func TestMinMaxStack(t *testing.T) {
+	testStack(t, func() Stack[int] { return &MinMaxStack[int]{} })
+
+	t.Run("empty", func(t *testing.T) {
+		s := &MinMaxStack[int]{}
+
+		if v, ok := s.Min(); v != 0 || ok {
+			t.Errorf("Min() = (%v, %v), want (0, false)", v, ok)
+		}
+		if v, ok := s.Max(); v != 0 || ok {
+			t.Errorf("Max() = (%v, %v), want (0, false)", v, ok)
+		}
+	})
+
+	t.Run("random", func(t *testing.T) {
+		r := rand.New(rand.NewPCG(1, 2))
+		s := &MinMaxStack[int]{}
+		var values []int
+
+		for i := range 5000 {
+			if r.IntN(3) == 0 {
+				s.Pop()
+				if len(values) > 0 {
+					values = values[:len(values)-1]
+				}
+			} else {
+				v := r.IntN(1000) - 500
+				s.Push(v)
+				values = append(values, v)
+			}
+
+			if len(values) == 0 {
+				continue
+			}
+
+			if got, _ := s.Min(); got != slices.Min(values) {
+				t.Fatalf("%d: Min() = %d, want %d", i, got, slices.Min(values))
+			}
+			if got, _ := s.Max(); got != slices.Max(values) {
+				t.Fatalf("%d: Max() = %d, want %d", i, got, slices.Max(values))
+			}
+		}
+	})
+
+	t.Run("String", func(t *testing.T) {
+		s := &MinMaxStack[int]{}
+		fill(s, 1, 2, 3)
+
+		if got, want := s.String(), "MinMaxStack{[1 2 3]}"; got != want {
+			t.Errorf("String() = %q, want %q", got, want)
+		}
+	})
+}
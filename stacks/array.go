@@ -1,17 +1,58 @@
 package stacks
 
-import "fmt"
+import (
+	"fmt"
+	"iter"
+	"slices"
+)
 
 var _ Stack[int] = &StackArray[int]{}
 
+// minShrinkCap is the capacity below which StackArray never shrinks.
+const minShrinkCap = 64
+
+// StackArray is a [Stack] implementation that uses an array underneath.
+//
+// StackArray releases memory as it drains: whenever its length drops
+// to a quarter of its capacity, the underlying array is reallocated
+// with twice its length as capacity, never going below the capacity
+// it was created with by [NewStackArray].
+//
+// The zero value is an empty StackArray ready to use.
 type StackArray[T any] struct {
-	arr []T
+	arr    []T
+	minCap int
+}
+
+// NewStackArray returns an empty StackArray with preallocated capacity.
+// StackArray never shrinks below capacity.
+func NewStackArray[T any](capacity int) *StackArray[T] {
+	return &StackArray[T]{
+		arr:    make([]T, 0, capacity),
+		minCap: capacity,
+	}
+}
+
+// FromSlice returns a StackArray with a copy of s, where the last
+// element of s is the top of the stack.
+func FromSlice[T any](s []T) *StackArray[T] {
+	return &StackArray[T]{arr: slices.Clone(s)}
 }
 
 func (s *StackArray[T]) Push(value T) {
 	s.arr = append(s.arr, value)
 }
 
+// PushAll pushes every value of values, in order, so the last
+// value yielded ends up at the top of StackArray.
+//
+// Time O(k) amortized, where k is the number of values.
+func (s *StackArray[T]) PushAll(values iter.Seq[T]) {
+	for v := range values {
+		s.arr = append(s.arr, v)
+	}
+}
+
 func (s *StackArray[T]) Pop() (T, bool) {
 	length := len(s.arr)
 	if length == 0 {
@@ -20,11 +61,31 @@ func (s *StackArray[T]) Pop() (T, bool) {
 	}
 
 	value := s.arr[length-1]
+
+	var zero T
+	s.arr[length-1] = zero
 	s.arr = s.arr[:length-1]
+	s.shrink()
 
 	return value, true
 }
 
+// PopN removes and returns up to n values from the top of StackArray,
+// ordered from top to bottom.
+// It panics if n < 0.
+//
+// Time O(n) and space O(n).
+func (s *StackArray[T]) PopN(n int) []T {
+	values := s.PeekN(n)
+
+	length := len(s.arr) - len(values)
+	clear(s.arr[length:])
+	s.arr = s.arr[:length]
+	s.shrink()
+
+	return values
+}
+
 func (s *StackArray[T]) Peek() (T, bool) {
 	length := len(s.arr)
 	if length == 0 {
@@ -35,10 +96,80 @@ func (s *StackArray[T]) Peek() (T, bool) {
 	return s.arr[length-1], true
 }
 
+// PeekN returns up to n values from the top of StackArray, ordered
+// from top to bottom, without removing them.
+// It panics if n < 0.
+//
+// Time O(n) and space O(n).
+func (s *StackArray[T]) PeekN(n int) []T {
+	if n < 0 {
+		panic(fmt.Sprintf("negative count %d", n))
+	}
+
+	n = min(n, len(s.arr))
+	values := make([]T, n)
+
+	for i := range n {
+		values[i] = s.arr[len(s.arr)-1-i]
+	}
+
+	return values
+}
+
+// Clear removes all values from StackArray.
+func (s *StackArray[T]) Clear() {
+	if cap(s.arr) > max(minShrinkCap, s.minCap) {
+		s.arr = make([]T, 0, s.minCap)
+		return
+	}
+
+	clear(s.arr)
+	s.arr = s.arr[:0]
+}
+
 func (s *StackArray[T]) Len() int {
 	return len(s.arr)
 }
 
+// Values returns an iterator over StackArray's elements
+// from top to bottom.
+func (s *StackArray[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := len(s.arr) - 1; i >= 0; i-- {
+			if !yield(s.arr[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Clone returns a copy of StackArray.
+//
+// Time O(n) and space O(n).
+func (s *StackArray[T]) Clone() *StackArray[T] {
+	arr := make([]T, len(s.arr), max(len(s.arr), s.minCap))
+	copy(arr, s.arr)
+
+	return &StackArray[T]{
+		arr:    arr,
+		minCap: s.minCap,
+	}
+}
+
 func (s *StackArray[T]) String() string {
 	return fmt.Sprintf("StackArray{%v}", s.arr)
 }
+
+// shrink reallocates the underlying array with twice StackArray's
+// length as capacity once the length drops to a quarter of it, so a
+// single call releases all the excess memory, even after PopN.
+func (s *StackArray[T]) shrink() {
+	capacity := cap(s.arr)
+	if capacity <= max(minShrinkCap, s.minCap) || len(s.arr) > capacity/4 {
+		return
+	}
+
+	arr := make([]T, len(s.arr), max(2*len(s.arr), minShrinkCap, s.minCap))
+	copy(arr, s.arr)
+	s.arr = arr
+}
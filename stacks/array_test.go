@@ -1,117 +1,147 @@
 package stacks
 
 import (
-	"fmt"
 	"reflect"
+	"slices"
 	"testing"
 )
 
 func TestStackArray(t *testing.T) {
-	t.Run("Push", func(t *testing.T) {
-		tests := []struct {
-			stack *StackArray[int]
-			value int
-			want  *StackArray[int]
-		}{
-			{
-				&StackArray[int]{},
-				2,
-				&StackArray[int]{[]int{2}},
-			},
-			{
-				&StackArray[int]{[]int{2}},
-				1,
-				&StackArray[int]{[]int{2, 1}},
-			},
-		}
+	testStack(t, func() Stack[int] { return &StackArray[int]{} })
 
-		for i, test := range tests {
-			before := fmt.Sprint(test.stack)
-			test.stack.Push(test.value)
+	t.Run("String", func(t *testing.T) {
+		s := &StackArray[int]{}
+		fill(s, 1, 2, 3)
 
-			if !reflect.DeepEqual(test.stack, test.want) {
-				t.Errorf("%d: %v.Push(%v) != %v", i, before, test.value, test.want)
-			}
+		if got, want := s.String(), "StackArray{[1 2 3]}"; got != want {
+			t.Errorf("String() = %q, want %q", got, want)
 		}
 	})
+}
+
+func TestStackArrayPushAll(t *testing.T) {
+	s := FromSlice([]int{1})
+	s.PushAll(slices.Values([]int{2, 3}))
+
+	if got, want := slices.Collect(s.Values()), []int{3, 2, 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("%v.Values() = %v, want %v", s, got, want)
+	}
+}
+
+func TestStackArrayPopN(t *testing.T) {
+	tests := []struct {
+		stack     []int
+		n         int
+		want      []int
+		wantStack []int
+	}{
+		{
+			nil,
+			0,
+			[]int{},
+			nil,
+		},
+		{
+			nil,
+			1,
+			[]int{},
+			nil,
+		},
+		{
+			[]int{1, 2, 3},
+			2,
+			[]int{3, 2},
+			[]int{1},
+		},
+		{
+			[]int{1, 2, 3},
+			5,
+			[]int{3, 2, 1},
+			[]int{},
+		},
+	}
+
+	for i, test := range tests {
+		s := FromSlice(test.stack)
+
+		if got := s.PeekN(test.n); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%d: %v.PeekN(%d) = %v, want %v", i, s, test.n, got, test.want)
+		}
+
+		if got := s.PopN(test.n); !reflect.DeepEqual(got, test.want) || !reflect.DeepEqual(s.arr, test.wantStack) {
+			t.Errorf("%d: %v.PopN(%d) = (%v, %v), want (%v, %v)", i, test.stack, test.n, got, s.arr, test.want, test.wantStack)
+		}
+	}
+
+	if !panics(func() { FromSlice([]int{1}).PopN(-1) }) {
+		t.Errorf("PopN(-1) expected to panic")
+	}
+}
+
+func TestStackArrayClone(t *testing.T) {
+	s := FromSlice([]int{1, 2})
+	c := s.Clone()
+	c.Push(3)
+	s.Clear()
+
+	if s.Len() != 0 || !reflect.DeepEqual(c.arr, []int{1, 2, 3}) {
+		t.Errorf("Clone() and Clear() = (%v, %v), want (StackArray{[]}, StackArray{[1 2 3]})", s, c)
+	}
+}
+
+func TestStackArrayShrink(t *testing.T) {
+	const length = 1 << 16
 
 	t.Run("Pop", func(t *testing.T) {
-		tests := []struct {
-			stack     *StackArray[int]
-			wantValue int
-			wantBool  bool
-			wantStack *StackArray[int]
-		}{
-			{
-				&StackArray[int]{[]int{2, 1}},
-				1,
-				true,
-				&StackArray[int]{[]int{2}},
-			},
-			{
-				&StackArray[int]{[]int{2}},
-				2,
-				true,
-				&StackArray[int]{[]int{}},
-			},
-			{
-				&StackArray[int]{},
-				0,
-				false,
-				&StackArray[int]{},
-			},
+		s := &StackArray[int]{}
+		for i := range length {
+			s.Push(i)
+		}
+
+		for s.Len() > 0 {
+			s.Pop()
 		}
 
-		for i, test := range tests {
-			before := fmt.Sprint(test.stack)
-
-			if gotValue, gotBool := test.stack.Pop(); gotValue != test.wantValue ||
-				gotBool != test.wantBool ||
-				!reflect.DeepEqual(test.stack, test.wantStack) {
-				t.Errorf(
-					"%d: %v.Pop() != (%v, %v), want (%v, %v) and %v",
-					i, before, gotValue, gotBool, test.wantValue, test.wantBool, test.wantStack,
-				)
-			}
+		if got := cap(s.arr); got > minShrinkCap {
+			t.Errorf("cap after draining = %d, want <= %d", got, minShrinkCap)
 		}
 	})
 
-	t.Run("Peek", func(t *testing.T) {
-		tests := []struct {
-			stack     *StackArray[int]
-			wantValue int
-			wantBool  bool
-			wantStack *StackArray[int]
-		}{
-			{
-				&StackArray[int]{},
-				0,
-				false,
-				&StackArray[int]{},
-			},
-			{
-				&StackArray[int]{[]int{2}},
-				2,
-				true,
-				&StackArray[int]{[]int{2}},
-			},
-			{
-				&StackArray[int]{[]int{2, 1}},
-				1,
-				true,
-				&StackArray[int]{[]int{2, 1}},
-			},
+	t.Run("PopN", func(t *testing.T) {
+		s := &StackArray[int]{}
+		for i := range length {
+			s.Push(i)
+		}
+
+		s.PopN(length - 10)
+
+		if got := cap(s.arr); got > minShrinkCap {
+			t.Errorf("cap after PopN(%d) = %d, want <= %d", length-10, got, minShrinkCap)
+		}
+
+		s.PopN(10)
+
+		if got := cap(s.arr); got > minShrinkCap {
+			t.Errorf("cap after draining = %d, want <= %d", got, minShrinkCap)
+		}
+	})
+
+	t.Run("minimum capacity", func(t *testing.T) {
+		s := NewStackArray[int](length)
+		for i := range 2 * length {
+			s.Push(i)
+		}
+
+		s.Clear()
+		for i := range length {
+			s.Push(i)
+		}
+		for s.Len() > 0 {
+			s.Pop()
 		}
 
-		for i, test := range tests {
-			if gotValue, gotBool := test.stack.Peek(); gotValue != test.wantValue ||
-				gotBool != test.wantBool ||
-				!reflect.DeepEqual(test.stack, test.wantStack) {
-				t.Errorf(
-					"%d: %v.Peek() != (%v, %v), want (%v, %v) and %v",
-					i, test.stack, gotValue, gotBool, test.wantValue, test.wantBool, test.wantStack,
-				)
-			}
+		if got := cap(s.arr); got != length {
+			t.Errorf("cap after draining = %d, want %d", got, length)
 		}
 	})
 }
@@ -0,0 +1,154 @@
+package stacks
+
+import "fmt"
+
+var _ Stack[int] = &BoundedStack[int]{}
+
+// OverflowPolicy defines how a [BoundedStack] handles pushes
+// when it is full.
+type OverflowPolicy int
+
+const (
+	// Reject discards the pushed value.
+	Reject OverflowPolicy = iota
+	// DropOldest discards the value at the bottom of the stack
+	// to make room for the pushed value.
+	DropOldest
+	// Panic panics.
+	Panic
+)
+
+// BoundedStack is a [Stack] implementation with a fixed capacity that
+// uses a ring buffer underneath, so dropping the oldest value is O(1).
+//
+// A BoundedStack must be created with [NewBoundedStack]. The zero value
+// has no capacity, and pushing to it panics rather than discarding values.
+type BoundedStack[T any] struct {
+	ring []T
+	// bottom is the index in ring of the value at the bottom of the stack.
+	bottom  int
+	len     int
+	policy  OverflowPolicy
+	onEvict func(T)
+}
+
+// NewBoundedStack returns an empty BoundedStack that holds at most
+// capacity values and handles overflows according to policy.
+//
+// If onEvict is not nil, it is called with every value the stack
+// discards: the bottom value under [DropOldest] and the pushed
+// value under [Reject].
+//
+// It panics if capacity < 1.
+func NewBoundedStack[T any](capacity int, policy OverflowPolicy, onEvict func(T)) *BoundedStack[T] {
+	if capacity < 1 {
+		panic(fmt.Sprintf("non-positive capacity %d", capacity))
+	}
+
+	return &BoundedStack[T]{
+		ring:    make([]T, capacity),
+		policy:  policy,
+		onEvict: onEvict,
+	}
+}
+
+// Push adds value to the top of BoundedStack. When BoundedStack
+// is full, it behaves according to its [OverflowPolicy].
+// It panics if BoundedStack was not created with [NewBoundedStack].
+//
+// Time O(1) and space O(1).
+func (s *BoundedStack[T]) Push(value T) {
+	s.TryPush(value)
+}
+
+// TryPush adds value to the top of BoundedStack and reports whether
+// it was added. It only returns false when BoundedStack is full and
+// its policy is [Reject].
+// It panics if BoundedStack was not created with [NewBoundedStack].
+//
+// Time O(1) and space O(1).
+func (s *BoundedStack[T]) TryPush(value T) bool {
+	if len(s.ring) == 0 {
+		panic("push to BoundedStack not created with NewBoundedStack")
+	}
+
+	if s.len == len(s.ring) {
+		switch s.policy {
+		case Reject:
+			s.evict(value)
+			return false
+		case DropOldest:
+			evicted := s.ring[s.bottom]
+			s.ring[s.bottom] = value
+			s.bottom = (s.bottom + 1) % len(s.ring)
+			s.evict(evicted)
+			return true
+		default:
+			panic(fmt.Sprintf("push to full stack with capacity %d", len(s.ring)))
+		}
+	}
+
+	s.ring[(s.bottom+s.len)%len(s.ring)] = value
+	s.len++
+
+	return true
+}
+
+// Pop attempts to remove and return the value at the top of
+// BoundedStack and reports whether it succeeded.
+//
+// Time O(1) and space O(1).
+func (s *BoundedStack[T]) Pop() (T, bool) {
+	var zero T
+	if s.len == 0 {
+		return zero, false
+	}
+
+	top := (s.bottom + s.len - 1) % len(s.ring)
+	value := s.ring[top]
+	s.ring[top] = zero
+	s.len--
+
+	return value, true
+}
+
+// Peek attempts to return the value at the top of BoundedStack
+// without removing it and reports whether it succeeded.
+//
+// Time O(1) and space O(1).
+func (s *BoundedStack[T]) Peek() (T, bool) {
+	if s.len == 0 {
+		var v T
+		return v, false
+	}
+
+	return s.ring[(s.bottom+s.len-1)%len(s.ring)], true
+}
+
+// Len returns BoundedStack's length.
+func (s *BoundedStack[T]) Len() int {
+	return s.len
+}
+
+// Cap returns BoundedStack's capacity.
+func (s *BoundedStack[T]) Cap() int {
+	return len(s.ring)
+}
+
+// String formats BoundedStack's values from bottom to top,
+// the same way as [StackArray.String].
+func (s *BoundedStack[T]) String() string {
+	values := make([]T, s.len)
+
+	for i := range s.len {
+		values[i] = s.ring[(s.bottom+i)%len(s.ring)]
+	}
+
+	return fmt.Sprintf("BoundedStack{%v}", values)
+}
+
+func (s *BoundedStack[T]) evict(value T) {
+	if s.onEvict != nil {
+		s.onEvict(value)
+	}
+}
@@ -0,0 +1,96 @@
+package stacks
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBoundedStack(t *testing.T) {
+	testStack(t, func() Stack[int] { return NewBoundedStack[int](1000, Panic, nil) })
+
+	t.Run("String", func(t *testing.T) {
+		s := NewBoundedStack[int](3, DropOldest, nil)
+		fill(s, 1, 2, 3, 4)
+
+		if got, want := s.String(), "BoundedStack{[2 3 4]}"; got != want {
+			t.Errorf("String() = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("zero value", func(t *testing.T) {
+		s := &BoundedStack[int]{}
+
+		if !panics(func() { s.Push(1) }) {
+			t.Errorf("Push() to a zero value BoundedStack expected to panic")
+		}
+		if _, ok := s.Pop(); ok {
+			t.Errorf("Pop() on a zero value BoundedStack = (_, true), want false")
+		}
+	})
+}
+
+func TestBoundedStackOverflow(t *testing.T) {
+	tests := []struct {
+		policy      OverflowPolicy
+		values      []int
+		wantPushed  []bool
+		wantStack   []int
+		wantEvicted []int
+	}{
+		{
+			Reject,
+			[]int{1, 2, 3, 4},
+			[]bool{true, true, false, false},
+			[]int{1, 2},
+			[]int{3, 4},
+		},
+		{
+			DropOldest,
+			[]int{1, 2, 3, 4, 5},
+			[]bool{true, true, true, true, true},
+			[]int{4, 5},
+			[]int{1, 2, 3},
+		},
+		{
+			Panic,
+			[]int{1, 2},
+			[]bool{true, true},
+			[]int{1, 2},
+			nil,
+		},
+	}
+
+	for i, test := range tests {
+		var evicted []int
+		s := NewBoundedStack(2, test.policy, func(v int) { evicted = append(evicted, v) })
+
+		var pushed []bool
+		for _, v := range test.values {
+			pushed = append(pushed, s.TryPush(v))
+		}
+
+		if gotStack := drain(s); !reflect.DeepEqual(pushed, test.wantPushed) ||
+			!reflect.DeepEqual(gotStack, test.wantStack) ||
+			!reflect.DeepEqual(evicted, test.wantEvicted) {
+			t.Errorf(
+				"%d: TryPush(%v) = %v, stack %v and evicted %v, want %v, stack %v and evicted %v",
+				i, test.values, pushed, gotStack, evicted, test.wantPushed, test.wantStack, test.wantEvicted,
+			)
+		}
+	}
+
+	t.Run("Panic", func(t *testing.T) {
+		s := NewBoundedStack[int](1, Panic, nil)
+		s.Push(1)
+
+		if !panics(func() { s.Push(2) }) {
+			t.Errorf("Push on full stack expected to panic")
+		}
+	})
+
+	t.Run("capacity", func(t *testing.T) {
+		if !panics(func() { NewBoundedStack[int](0, Reject, nil) }) {
+			t.Errorf("NewBoundedStack(0) expected to panic")
+		}
+	})
+}
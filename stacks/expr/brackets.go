@@ -0,0 +1,60 @@
+package expr
+
+import (
+	"fmt"
+
+	"dsa/stacks"
+)
+
+var closing = map[byte]byte{
+	')': '(',
+	']': '[',
+	'}': '{',
+}
+
+// ValidateBrackets checks whether every bracket of s, one of ()[]{},
+// is closed by a bracket of the same type in the correct order.
+// Other characters are ignored. The returned [*Error] points to the
+// first offending bracket.
+//
+// stack holds the positions of unclosed brackets and must be empty.
+//
+// Time O(n) and space O(n).
+func ValidateBrackets(s string, stack stacks.Stack[int]) error {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+
+		switch c {
+		case '(', '[', '{':
+			stack.Push(i)
+		case ')', ']', '}':
+			open, ok := stack.Pop()
+			if !ok {
+				return &Error{i, fmt.Sprintf("unexpected %q", c)}
+			}
+
+			if s[open] != closing[c] {
+				return &Error{i, fmt.Sprintf("%q does not close %q at position %d", c, s[open], open)}
+			}
+		}
+	}
+
+	// report the outermost unclosed bracket
+	var (
+		open     int
+		unclosed bool
+	)
+	for {
+		i, ok := stack.Pop()
+		if !ok {
+			break
+		}
+		open, unclosed = i, true
+	}
+
+	if unclosed {
+		return &Error{open, fmt.Sprintf("unclosed %q", s[open])}
+	}
+
+	return nil
+}
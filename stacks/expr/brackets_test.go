@@ -0,0 +1,50 @@
+package expr
+
+import (
+	"errors"
+	"testing"
+
+	"dsa/stacks"
+)
+
+func TestValidateBrackets(t *testing.T) {
+	tests := []struct {
+		s       string
+		wantPos int
+	}{
+		{"", -1},
+		{"abc", -1},
+		{"()", -1},
+		{"([]{})", -1},
+		{"f(a[1], {b: (c)})", -1},
+		{")", 0},
+		{"())", 2},
+		{"(]", 1},
+		{"([)]", 2},
+		{"((", 0},
+		{"a{b(c)", 1},
+	}
+
+	newStacks := []func() stacks.Stack[int]{
+		func() stacks.Stack[int] { return &stacks.StackArray[int]{} },
+		func() stacks.Stack[int] { return &stacks.StackLinked[int]{} },
+	}
+
+	for i, test := range tests {
+		for _, newStack := range newStacks {
+			err := ValidateBrackets(test.s, newStack())
+
+			if test.wantPos == -1 {
+				if err != nil {
+					t.Errorf("%d: ValidateBrackets(%q) = %v, want nil", i, test.s, err)
+				}
+				continue
+			}
+
+			var exprErr *Error
+			if !errors.As(err, &exprErr) || exprErr.Pos != test.wantPos {
+				t.Errorf("%d: ValidateBrackets(%q) = %v, want error at position %d", i, test.s, err, test.wantPos)
+			}
+		}
+	}
+}
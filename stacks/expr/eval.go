@@ -0,0 +1,146 @@
+package expr
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	"dsa/stacks"
+)
+
+// Numeric is the set of types expressions can be evaluated to.
+type Numeric interface {
+	int | float64
+}
+
+// Eval evaluates the infix expression expr using [DefaultOperators]
+// and [stacks.StackArray] for every intermediate step.
+func Eval[N Numeric](expr string) (N, error) {
+	tokens, err := Tokenize(expr, DefaultOperators)
+	if err != nil {
+		return 0, err
+	}
+
+	postfix, err := ToPostfix(tokens, DefaultOperators, &stacks.StackArray[Token]{})
+	if err != nil {
+		return 0, err
+	}
+
+	return EvalPostfix(postfix, &stacks.StackArray[N]{})
+}
+
+// EvalPostfix evaluates postfix tokens, as returned by [ToPostfix],
+// supporting the binary operators of [DefaultOperators].
+// For ints, "/" and "%" are integer operations and "^" requires
+// a non-negative exponent.
+//
+// stack holds intermediate operands and must be empty.
+//
+// Time O(n) and space O(n).
+func EvalPostfix[N Numeric](postfix []Token, stack stacks.Stack[N]) (N, error) {
+	for _, token := range postfix {
+		switch token.Kind {
+		case Number:
+			value, err := parse[N](token.Text)
+			if err != nil {
+				return 0, &Error{token.Pos, fmt.Sprintf("invalid number %q", token.Text)}
+			}
+
+			stack.Push(value)
+
+		case Operator:
+			b, okB := stack.Pop()
+			a, okA := stack.Pop()
+			if !okA || !okB {
+				return 0, &Error{token.Pos, fmt.Sprintf("missing operand for %q", token.Text)}
+			}
+
+			value, err := apply(token.Text, a, b)
+			if err != nil {
+				return 0, &Error{token.Pos, err.Error()}
+			}
+
+			stack.Push(value)
+
+		default:
+			return 0, &Error{token.Pos, fmt.Sprintf("unexpected token %q", token.Text)}
+		}
+	}
+
+	value, ok := stack.Pop()
+	if !ok {
+		return 0, &Error{0, "empty expression"}
+	}
+	if stack.Len() != 0 {
+		return 0, &Error{0, fmt.Sprintf("%d operands left without operator", stack.Len())}
+	}
+
+	return value, nil
+}
+
+func parse[N Numeric](text string) (N, error) {
+	var zero N
+
+	if _, ok := any(zero).(int); ok {
+		v, err := strconv.Atoi(text)
+		return N(v), err
+	}
+
+	v, err := strconv.ParseFloat(text, 64)
+	return N(v), err
+}
+
+func apply[N Numeric](op string, a, b N) (N, error) {
+	switch op {
+	case "+":
+		return a + b, nil
+	case "-":
+		return a - b, nil
+	case "*":
+		return a * b, nil
+	}
+
+	switch x := any(a).(type) {
+	case int:
+		y := any(b).(int)
+
+		switch op {
+		case "/", "%":
+			if y == 0 {
+				return 0, fmt.Errorf("division by zero")
+			}
+			if op == "/" {
+				return N(x / y), nil
+			}
+			return N(x % y), nil
+		case "^":
+			if y < 0 {
+				return 0, fmt.Errorf("negative exponent %d", y)
+			}
+
+			// exponentiation by squaring
+			r := 1
+			for ; y > 0; y /= 2 {
+				if y%2 == 1 {
+					r *= x
+				}
+				x *= x
+			}
+			return N(r), nil
+		}
+
+	case float64:
+		y := any(b).(float64)
+
+		switch op {
+		case "/":
+			return N(x / y), nil
+		case "%":
+			return N(math.Mod(x, y)), nil
+		case "^":
+			return N(math.Pow(x, y)), nil
+		}
+	}
+
+	return 0, fmt.Errorf("unknown operator %q", op)
+}
@@ -0,0 +1,61 @@
+package expr
+
+import (
+	"testing"
+)
+
+func TestEvalInt(t *testing.T) {
+	tests := []struct {
+		expr string
+		want int
+	}{
+		{"42", 42},
+		{"1 + 2 * 3", 7},
+		{"(1 + 2) * 3", 9},
+		{"10 - 4 - 3", 3},
+		{"7 / 2", 3},
+		{"7 % 4", 3},
+		{"2 ^ 3 ^ 2", 512},
+		{"2 ^ 0", 1},
+	}
+
+	for i, test := range tests {
+		if got, err := Eval[int](test.expr); got != test.want || err != nil {
+			t.Errorf("%d: Eval[int](%q) = (%v, %v), want %v", i, test.expr, got, err, test.want)
+		}
+	}
+}
+
+func TestEvalFloat(t *testing.T) {
+	tests := []struct {
+		expr string
+		want float64
+	}{
+		{"1.5 + 2", 3.5},
+		{"7 / 2", 3.5},
+		{"2 ^ 0.5 ^ 2", 1.189207115002721},
+		{"5.5 % 2", 1.5},
+	}
+
+	for i, test := range tests {
+		if got, err := Eval[float64](test.expr); got != test.want || err != nil {
+			t.Errorf("%d: Eval[float64](%q) = (%v, %v), want %v", i, test.expr, got, err, test.want)
+		}
+	}
+}
+
+func TestEvalError(t *testing.T) {
+	tests := []string{
+		"",
+		"1 / 0",
+		"1 % 0",
+		"2 ^ (0 - 1)",
+		"1..2 + 1",
+	}
+
+	for i, expr := range tests {
+		if got, err := Eval[int](expr); err == nil {
+			t.Errorf("%d: Eval[int](%q) = (%v, nil), want error", i, expr, got)
+		}
+	}
+}
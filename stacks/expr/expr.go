@@ -0,0 +1,124 @@
+// Package expr implements expression parsing algorithms built on
+// the [stacks.Stack] interface: a balanced-bracket validator, infix
+// to postfix conversion and postfix evaluation.
+//
+// Every algorithm takes the Stack it uses as scratch space, so any
+// Stack implementation can be plugged in. Such stacks must be empty.
+package expr
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Error is the error returned by this package's functions,
+// it reports where in the input the problem happened.
+type Error struct {
+	// Pos is the byte offset in the input expression.
+	Pos int
+	Msg string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("expr: %s at position %d", e.Msg, e.Pos)
+}
+
+// Kind is the kind of a Token.
+type Kind int
+
+const (
+	Number Kind = iota
+	Operator
+	LeftParen
+	RightParen
+)
+
+// Token is a lexical unit of an expression.
+type Token struct {
+	Kind Kind
+	Text string
+	// Pos is the byte offset of Token in the input expression.
+	Pos int
+}
+
+// Associativity defines how operators of the same precedence group.
+type Associativity int
+
+const (
+	// LeftAssoc groups from the left, a-b-c is (a-b)-c.
+	LeftAssoc Associativity = iota
+	// RightAssoc groups from the right, a^b^c is a^(b^c).
+	RightAssoc
+)
+
+// OperatorInfo holds the parsing rules of a binary operator.
+type OperatorInfo struct {
+	// Precedence defines binding strength, higher binds tighter.
+	Precedence    int
+	Associativity Associativity
+}
+
+// Operators is a table of binary operators keyed by their symbol.
+type Operators map[string]OperatorInfo
+
+// DefaultOperators is the table of arithmetic operators supported
+// by [EvalPostfix].
+var DefaultOperators = Operators{
+	"+": {1, LeftAssoc},
+	"-": {1, LeftAssoc},
+	"*": {2, LeftAssoc},
+	"/": {2, LeftAssoc},
+	"%": {2, LeftAssoc},
+	"^": {3, RightAssoc},
+}
+
+// Tokenize splits expr into numbers, parentheses and operators
+// found in ops, skipping whitespace.
+// When operators share a prefix, the longest one is chosen.
+//
+// Time O(n*k) and space O(n), where k is the number of operators.
+func Tokenize(expr string, ops Operators) ([]Token, error) {
+	var tokens []Token
+
+	for i := 0; i < len(expr); {
+		c := expr[i]
+
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '(':
+			tokens = append(tokens, Token{LeftParen, "(", i})
+			i++
+		case c == ')':
+			tokens = append(tokens, Token{RightParen, ")", i})
+			i++
+		case isDigit(c) || c == '.':
+			j := i
+			for j < len(expr) && (isDigit(expr[j]) || expr[j] == '.') {
+				j++
+			}
+			tokens = append(tokens, Token{Number, expr[i:j], i})
+			i = j
+		default:
+			op := ""
+			for symbol := range ops {
+				if len(symbol) > len(op) && strings.HasPrefix(expr[i:], symbol) {
+					op = symbol
+				}
+			}
+
+			if op == "" {
+				return nil, &Error{i, fmt.Sprintf("unexpected character %q", c)}
+			}
+
+			tokens = append(tokens, Token{Operator, op, i})
+			i += len(op)
+		}
+	}
+
+	return tokens, nil
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
@@ -0,0 +1,103 @@
+package expr
+
+import (
+	"fmt"
+
+	"dsa/stacks"
+)
+
+// ToPostfix converts infix tokens to postfix, reverse polish notation,
+// using the shunting-yard algorithm with the precedence and
+// associativity rules of ops. Parentheses are dropped from the output.
+//
+// stack holds pending operators and parentheses and must be empty.
+//
+// Time O(n) and space O(n).
+func ToPostfix(tokens []Token, ops Operators, stack stacks.Stack[Token]) ([]Token, error) {
+	output := make([]Token, 0, len(tokens))
+	expectOperand := true
+
+	for _, token := range tokens {
+		switch token.Kind {
+		case Number:
+			if !expectOperand {
+				return nil, &Error{token.Pos, fmt.Sprintf("unexpected number %q", token.Text)}
+			}
+
+			output = append(output, token)
+			expectOperand = false
+
+		case LeftParen:
+			if !expectOperand {
+				return nil, &Error{token.Pos, "unexpected '('"}
+			}
+
+			stack.Push(token)
+
+		case RightParen:
+			if expectOperand {
+				return nil, &Error{token.Pos, "unexpected ')'"}
+			}
+
+			for {
+				top, ok := stack.Pop()
+				if !ok {
+					return nil, &Error{token.Pos, "unmatched ')'"}
+				}
+				if top.Kind == LeftParen {
+					break
+				}
+				output = append(output, top)
+			}
+
+		case Operator:
+			info, ok := ops[token.Text]
+			if !ok {
+				return nil, &Error{token.Pos, fmt.Sprintf("unknown operator %q", token.Text)}
+			}
+			if expectOperand {
+				return nil, &Error{token.Pos, fmt.Sprintf("missing operand for %q", token.Text)}
+			}
+
+			for {
+				top, ok := stack.Peek()
+				if !ok || top.Kind != Operator {
+					break
+				}
+
+				topInfo := ops[top.Text]
+				if topInfo.Precedence < info.Precedence ||
+					topInfo.Precedence == info.Precedence && info.Associativity == RightAssoc {
+					break
+				}
+
+				stack.Pop()
+				output = append(output, top)
+			}
+
+			stack.Push(token)
+			expectOperand = true
+
+		default:
+			return nil, &Error{token.Pos, fmt.Sprintf("unknown token %q", token.Text)}
+		}
+	}
+
+	if expectOperand && len(tokens) != 0 {
+		last := tokens[len(tokens)-1]
+		return nil, &Error{last.Pos, fmt.Sprintf("missing operand after %q", last.Text)}
+	}
+
+	for {
+		top, ok := stack.Pop()
+		if !ok {
+			break
+		}
+		if top.Kind == LeftParen {
+			return nil, &Error{top.Pos, "unmatched '('"}
+		}
+		output = append(output, top)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,96 @@
+package expr
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"dsa/stacks"
+)
+
+func postfixString(tokens []Token) string {
+	texts := make([]string, len(tokens))
+	for i, token := range tokens {
+		texts[i] = token.Text
+	}
+
+	return strings.Join(texts, " ")
+}
+
+func TestToPostfix(t *testing.T) {
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{"", ""},
+		{"1", "1"},
+		{"1 + 2", "1 2 +"},
+		{"1 + 2 * 3", "1 2 3 * +"},
+		{"(1 + 2) * 3", "1 2 + 3 *"},
+		{"1 - 2 - 3", "1 2 - 3 -"},
+		{"2 ^ 3 ^ 2", "2 3 2 ^ ^"},
+		{"3 + 4 * 2 / (1 - 5) ^ 2 ^ 3", "3 4 2 * 1 5 - 2 3 ^ ^ / +"},
+	}
+
+	for i, test := range tests {
+		tokens, err := Tokenize(test.expr, DefaultOperators)
+		if err != nil {
+			t.Fatalf("%d: Tokenize(%q) = %v", i, test.expr, err)
+		}
+
+		got, err := ToPostfix(tokens, DefaultOperators, &stacks.StackLinked[Token]{})
+		if err != nil || postfixString(got) != test.want {
+			t.Errorf("%d: ToPostfix(%q) = (%q, %v), want %q", i, test.expr, postfixString(got), err, test.want)
+		}
+	}
+}
+
+func TestToPostfixCustomOperators(t *testing.T) {
+	ops := Operators{
+		"**": {2, RightAssoc},
+		"*":  {1, LeftAssoc},
+	}
+
+	tokens, err := Tokenize("2 * 3 ** 2", ops)
+	if err != nil {
+		t.Fatalf("Tokenize() = %v", err)
+	}
+
+	got, err := ToPostfix(tokens, ops, &stacks.StackArray[Token]{})
+	if want := "2 3 2 ** *"; err != nil || postfixString(got) != want {
+		t.Errorf("ToPostfix() = (%q, %v), want %q", postfixString(got), err, want)
+	}
+}
+
+func TestToPostfixError(t *testing.T) {
+	tests := []struct {
+		expr    string
+		wantPos int
+	}{
+		{"1 +", 2},
+		{"+ 1", 0},
+		{"1 2", 2},
+		{"(1 + 2", 0},
+		{"1 + 2)", 5},
+		{"()", 1},
+		{"1 (2)", 2},
+	}
+
+	for i, test := range tests {
+		tokens, err := Tokenize(test.expr, DefaultOperators)
+		if err != nil {
+			t.Fatalf("%d: Tokenize(%q) = %v", i, test.expr, err)
+		}
+
+		_, err = ToPostfix(tokens, DefaultOperators, &stacks.StackArray[Token]{})
+
+		var exprErr *Error
+		if !errors.As(err, &exprErr) || exprErr.Pos != test.wantPos {
+			t.Errorf("%d: ToPostfix(%q) = %v, want error at position %d", i, test.expr, err, test.wantPos)
+		}
+	}
+
+	if _, err := Tokenize("1 & 2", DefaultOperators); err == nil {
+		t.Errorf("Tokenize(%q) = nil, want error", "1 & 2")
+	}
+}
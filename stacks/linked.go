@@ -0,0 +1,77 @@
+package stacks
+
+import "fmt"
+
+var _ Stack[int] = &StackLinked[int]{}
+
+// StackLinked is a [Stack] implementation that uses singly-linked
+// nodes underneath, so Push never reallocates existing elements.
+type StackLinked[T any] struct {
+	top *stackNode[T]
+	len int
+}
+
+type stackNode[T any] struct {
+	value T
+	next  *stackNode[T]
+}
+
+// Push adds value to the top of StackLinked.
+//
+// Time O(1) and space O(1).
+func (s *StackLinked[T]) Push(value T) {
+	s.top = &stackNode[T]{
+		value: value,
+		next:  s.top,
+	}
+	s.len++
+}
+
+// Pop attempts to remove and return the value at the top of
+// StackLinked and reports whether it succeeded.
+//
+// Time O(1) and space O(1).
+func (s *StackLinked[T]) Pop() (T, bool) {
+	if s.top == nil {
+		var v T
+		return v, false
+	}
+
+	value := s.top.value
+	s.top = s.top.next
+	s.len--
+
+	return value, true
+}
+
+// Peek attempts to return the value at the top of StackLinked
+// without removing it and reports whether it succeeded.
+//
+// Time O(1) and space O(1).
+func (s *StackLinked[T]) Peek() (T, bool) {
+	if s.top == nil {
+		var v T
+		return v, false
+	}
+
+	return s.top.value, true
+}
+
+// Len returns StackLinked's length.
+func (s *StackLinked[T]) Len() int {
+	return s.len
+}
+
+// String formats StackLinked's values from bottom to top,
+// the same way as [StackArray.String].
+func (s *StackLinked[T]) String() string {
+	values := make([]T, s.len)
+
+	i := s.len - 1
+	for n := s.top; n != nil; n = n.next {
+		values[i] = n.value
+		i--
+	}
+
+	return fmt.Sprintf("StackLinked{%v}", values)
+}
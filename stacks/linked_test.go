@@ -0,0 +1,16 @@
+package stacks
+
+import "testing"
+
+func TestStackLinked(t *testing.T) {
+	testStack(t, func() Stack[int] { return &StackLinked[int]{} })
+
+	t.Run("String", func(t *testing.T) {
+		s := &StackLinked[int]{}
+		fill(s, 1, 2, 3)
+
+		if got, want := s.String(), "StackLinked{[1 2 3]}"; got != want {
+			t.Errorf("String() = %q, want %q", got, want)
+		}
+	})
+}
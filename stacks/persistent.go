@@ -0,0 +1,156 @@
+package stacks
+
+import (
+	"fmt"
+	"iter"
+)
+
+var _ Stack[int] = &PersistentStackView[int]{}
+
+// PersistentStack is an immutable stack where every version shares
+// structure with the versions it was derived from. Push and Pop return
+// new stacks in O(1) without copying, leaving the receiver unchanged,
+// so every version remains valid and safe for concurrent reads.
+//
+// The zero value is an empty PersistentStack ready to use.
+type PersistentStack[T any] struct {
+	top *persistentNode[T]
+}
+
+type persistentNode[T any] struct {
+	value T
+	next  *persistentNode[T]
+	// len is the stack's length with this node at the top.
+	len int
+}
+
+// Push returns a new PersistentStack with value at the top.
+//
+// Time O(1) and space O(1).
+func (s PersistentStack[T]) Push(value T) PersistentStack[T] {
+	return PersistentStack[T]{
+		top: &persistentNode[T]{
+			value: value,
+			next:  s.top,
+			len:   s.Len() + 1,
+		},
+	}
+}
+
+// Pop attempts to return a new PersistentStack without its top value,
+// along with that value, and reports whether it succeeded.
+//
+// Time O(1) and space O(1).
+func (s PersistentStack[T]) Pop() (PersistentStack[T], T, bool) {
+	if s.top == nil {
+		var v T
+		return s, v, false
+	}
+
+	return PersistentStack[T]{top: s.top.next}, s.top.value, true
+}
+
+// Peek attempts to return the value at the top of PersistentStack
+// and reports whether it succeeded.
+//
+// Time O(1) and space O(1).
+func (s PersistentStack[T]) Peek() (T, bool) {
+	if s.top == nil {
+		var v T
+		return v, false
+	}
+
+	return s.top.value, true
+}
+
+// Len returns PersistentStack's length.
+func (s PersistentStack[T]) Len() int {
+	if s.top == nil {
+		return 0
+	}
+
+	return s.top.len
+}
+
+// Values returns an iterator over PersistentStack's elements
+// from top to bottom.
+func (s PersistentStack[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for n := s.top; n != nil; n = n.next {
+			if !yield(n.value) {
+				return
+			}
+		}
+	}
+}
+
+// String formats PersistentStack's values from bottom to top,
+// the same way as [StackArray.String].
+func (s PersistentStack[T]) String() string {
+	values := make([]T, s.Len())
+	for n := s.top; n != nil; n = n.next {
+		values[n.len-1] = n.value
+	}
+
+	return fmt.Sprintf("PersistentStack{%v}", values)
+}
+
+// View returns a mutable [Stack] view starting at PersistentStack.
+// Changes to the view never affect PersistentStack.
+func (s PersistentStack[T]) View() *PersistentStackView[T] {
+	return &PersistentStackView[T]{current: s}
+}
+
+// PersistentStackView is a mutable [Stack] over a [PersistentStack],
+// where each operation replaces the current version. Snapshots of the
+// current version can be taken in O(1) at any time.
+//
+// The zero value is an empty PersistentStackView ready to use.
+type PersistentStackView[T any] struct {
+	current PersistentStack[T]
+}
+
+// Push adds value to the top of the current version.
+//
+// Time O(1) and space O(1).
+func (v *PersistentStackView[T]) Push(value T) {
+	v.current = v.current.Push(value)
+}
+
+// Pop attempts to remove and return the value at the top of
+// the current version and reports whether it succeeded.
+//
+// Time O(1) and space O(1).
+func (v *PersistentStackView[T]) Pop() (T, bool) {
+	var (
+		value T
+		ok    bool
+	)
+	v.current, value, ok = v.current.Pop()
+
+	return value, ok
+}
+
+// Peek attempts to return the value at the top of the current
+// version and reports whether it succeeded.
+//
+// Time O(1) and space O(1).
+func (v *PersistentStackView[T]) Peek() (T, bool) {
+	return v.current.Peek()
+}
+
+// Len returns the current version's length.
+func (v *PersistentStackView[T]) Len() int {
+	return v.current.Len()
+}
+
+// Snapshot returns the current version.
+//
+// Time O(1) and space O(1).
+func (v *PersistentStackView[T]) Snapshot() PersistentStack[T] {
+	return v.current
+}
+
+func (v *PersistentStackView[T]) String() string {
+	return fmt.Sprintf("PersistentStackView{%v}", v.current)
+}
@@ -0,0 +1,92 @@
+package stacks
+
+import (
+	"reflect"
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestPersistentStack(t *testing.T) {
+	var empty PersistentStack[int]
+	s1 := empty.Push(1)
+	s2 := s1.Push(2)
+	s3, v, ok := s2.Pop()
+	s4 := s3.Push(3)
+
+	if v != 2 || !ok {
+		t.Errorf("%v.Pop() = (%v, %v), want (2, true)", s2, v, ok)
+	}
+	if _, _, ok := empty.Pop(); ok {
+		t.Errorf("%v.Pop() succeeded on empty stack", empty)
+	}
+
+	tests := []struct {
+		stack PersistentStack[int]
+		want  []int
+	}{
+		{empty, nil},
+		{s1, []int{1}},
+		{s2, []int{2, 1}},
+		{s3, []int{1}},
+		{s4, []int{3, 1}},
+	}
+
+	for i, test := range tests {
+		if got := slices.Collect(test.stack.Values()); !reflect.DeepEqual(got, test.want) || test.stack.Len() != len(test.want) {
+			t.Errorf("%d: %v.Values() = %v, want %v", i, test.stack, got, test.want)
+		}
+	}
+
+	if s3.top != s1.top || s4.top.next != s1.top {
+		t.Errorf("versions derived from %v do not share its nodes", s1)
+	}
+
+	if got, want := s4.String(), "PersistentStack{[1 3]}"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
+
+func TestPersistentStackConcurrentReads(t *testing.T) {
+	var s PersistentStack[int]
+	for i := range 100 {
+		s = s.Push(i)
+	}
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			version := s
+			for version.Len() > 0 {
+				version, _, _ = version.Pop()
+				version.Peek()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if s.Len() != 100 {
+		t.Errorf("Len() = %d, want 100", s.Len())
+	}
+}
+
+func TestPersistentStackView(t *testing.T) {
+	testStack(t, func() Stack[int] { return &PersistentStackView[int]{} })
+
+	t.Run("Snapshot", func(t *testing.T) {
+		base := PersistentStack[int]{}.Push(1)
+		view := base.View()
+
+		view.Push(2)
+		snapshot := view.Snapshot()
+		view.Pop()
+		view.Pop()
+
+		if base.Len() != 1 || snapshot.Len() != 2 || view.Len() != 0 {
+			t.Errorf("base %v, snapshot %v and view %v, want lengths 1, 2 and 0", base, snapshot, view)
+		}
+	})
+}
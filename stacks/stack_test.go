@@ -0,0 +1,185 @@
+package stacks
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		if e := recover(); e != nil {
+			panicked = true
+		}
+	}()
+
+	fn()
+
+	return panicked
+}
+
+// fill pushes values, from bottom to top, into s and returns it.
+func fill(s Stack[int], values ...int) Stack[int] {
+	for _, v := range values {
+		s.Push(v)
+	}
+
+	return s
+}
+
+// drain pops every value of s and returns them from bottom to top.
+func drain(s Stack[int]) []int {
+	var values []int
+
+	for {
+		v, ok := s.Pop()
+		if !ok {
+			break
+		}
+		values = append(values, v)
+	}
+
+	slices.Reverse(values)
+
+	return values
+}
+
+// testStack runs the conformance suite every [Stack] implementation
+// must pass. newStack must return an empty Stack.
+func testStack(t *testing.T, newStack func() Stack[int]) {
+	t.Helper()
+
+	t.Run("Push", func(t *testing.T) {
+		tests := []struct {
+			stack []int
+			value int
+			want  []int
+		}{
+			{
+				nil,
+				2,
+				[]int{2},
+			},
+			{
+				[]int{2},
+				1,
+				[]int{2, 1},
+			},
+		}
+
+		for i, test := range tests {
+			s := fill(newStack(), test.stack...)
+			s.Push(test.value)
+
+			if gotLen := s.Len(); gotLen != len(test.want) {
+				t.Errorf("%d: %v.Push(%v).Len() = %d, want %d", i, test.stack, test.value, gotLen, len(test.want))
+			}
+			if got := drain(s); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("%d: %v.Push(%v) = %v, want %v", i, test.stack, test.value, got, test.want)
+			}
+		}
+	})
+
+	t.Run("Pop", func(t *testing.T) {
+		tests := []struct {
+			stack     []int
+			wantValue int
+			wantBool  bool
+			wantStack []int
+		}{
+			{
+				[]int{2, 1},
+				1,
+				true,
+				[]int{2},
+			},
+			{
+				[]int{2},
+				2,
+				true,
+				nil,
+			},
+			{
+				nil,
+				0,
+				false,
+				nil,
+			},
+		}
+
+		for i, test := range tests {
+			s := fill(newStack(), test.stack...)
+
+			gotValue, gotBool := s.Pop()
+			gotLen := s.Len()
+
+			if gotStack := drain(s); gotValue != test.wantValue ||
+				gotBool != test.wantBool ||
+				gotLen != len(test.wantStack) ||
+				!reflect.DeepEqual(gotStack, test.wantStack) {
+				t.Errorf(
+					"%d: %v.Pop() != (%v, %v) and %v, want (%v, %v) and %v",
+					i, test.stack, gotValue, gotBool, gotStack, test.wantValue, test.wantBool, test.wantStack,
+				)
+			}
+		}
+	})
+
+	t.Run("Peek", func(t *testing.T) {
+		tests := []struct {
+			stack     []int
+			wantValue int
+			wantBool  bool
+		}{
+			{
+				nil,
+				0,
+				false,
+			},
+			{
+				[]int{2},
+				2,
+				true,
+			},
+			{
+				[]int{2, 1},
+				1,
+				true,
+			},
+		}
+
+		for i, test := range tests {
+			s := fill(newStack(), test.stack...)
+
+			gotValue, gotBool := s.Peek()
+
+			if gotStack := drain(s); gotValue != test.wantValue ||
+				gotBool != test.wantBool ||
+				!reflect.DeepEqual(gotStack, test.stack) {
+				t.Errorf(
+					"%d: %v.Peek() != (%v, %v) and %v, want (%v, %v) and %v",
+					i, test.stack, gotValue, gotBool, gotStack, test.wantValue, test.wantBool, test.stack,
+				)
+			}
+		}
+	})
+
+	t.Run("LIFO", func(t *testing.T) {
+		const length = 1000
+		s := newStack()
+
+		for i := range length {
+			s.Push(i)
+		}
+
+		for i := length - 1; i >= 0; i-- {
+			if v, ok := s.Pop(); v != i || !ok {
+				t.Fatalf("Pop() = (%v, %v), want (%v, true)", v, ok, i)
+			}
+		}
+
+		if s.Len() != 0 {
+			t.Errorf("Len() = %d, want 0", s.Len())
+		}
+	})
+}
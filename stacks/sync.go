@@ -0,0 +1,164 @@
+package stacks
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+var (
+	_ Stack[int] = &SyncStack[int]{}
+	_ Stack[int] = &TreiberStack[int]{}
+)
+
+// SyncStack wraps any [Stack] guarding every operation with a mutex,
+// so it is safe for concurrent use by multiple goroutines.
+type SyncStack[T any] struct {
+	mu    sync.Mutex
+	stack Stack[T]
+}
+
+// NewSyncStack returns a SyncStack wrapping stack.
+// stack must not be used directly after wrapping.
+func NewSyncStack[T any](stack Stack[T]) *SyncStack[T] {
+	return &SyncStack[T]{stack: stack}
+}
+
+// Push adds value to the top of the wrapped Stack.
+func (s *SyncStack[T]) Push(value T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.stack.Push(value)
+}
+
+// Pop attempts to remove and return the value at the top of the
+// wrapped Stack and reports whether it succeeded.
+func (s *SyncStack[T]) Pop() (T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.stack.Pop()
+}
+
+// Peek attempts to return the value at the top of the wrapped Stack
+// without removing it and reports whether it succeeded.
+func (s *SyncStack[T]) Peek() (T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.stack.Peek()
+}
+
+// Len returns the wrapped Stack's length.
+func (s *SyncStack[T]) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.stack.Len()
+}
+
+func (s *SyncStack[T]) String() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return fmt.Sprintf("SyncStack{%v}", s.stack)
+}
+
+// TreiberStack is a lock-free [Stack] implementation, safe for
+// concurrent use by multiple goroutines, that swaps its top node
+// with atomic compare-and-swap operations.
+//
+// The zero value is an empty TreiberStack ready to use.
+type TreiberStack[T any] struct {
+	top atomic.Pointer[treiberNode[T]]
+}
+
+// treiberNode is immutable once published, which prevents the
+// ABA problem since nodes are never reused while referenced.
+type treiberNode[T any] struct {
+	value T
+	next  *treiberNode[T]
+	// len is the stack's length with this node at the top.
+	len int
+}
+
+// Push adds value to the top of TreiberStack.
+//
+// Time O(1) without contention and space O(1).
+func (s *TreiberStack[T]) Push(value T) {
+	n := &treiberNode[T]{value: value}
+
+	for {
+		top := s.top.Load()
+		n.next = top
+		n.len = 1
+		if top != nil {
+			n.len += top.len
+		}
+
+		if s.top.CompareAndSwap(top, n) {
+			return
+		}
+	}
+}
+
+// Pop attempts to remove and return the value at the top of
+// TreiberStack and reports whether it succeeded.
+//
+// Time O(1) without contention and space O(1).
+func (s *TreiberStack[T]) Pop() (T, bool) {
+	for {
+		top := s.top.Load()
+		if top == nil {
+			var v T
+			return v, false
+		}
+
+		if s.top.CompareAndSwap(top, top.next) {
+			return top.value, true
+		}
+	}
+}
+
+// Peek attempts to return the value at the top of TreiberStack
+// without removing it and reports whether it succeeded.
+//
+// Time O(1) and space O(1).
+func (s *TreiberStack[T]) Peek() (T, bool) {
+	top := s.top.Load()
+	if top == nil {
+		var v T
+		return v, false
+	}
+
+	return top.value, true
+}
+
+// Len returns TreiberStack's length at the moment it is called.
+//
+// Time O(1) and space O(1).
+func (s *TreiberStack[T]) Len() int {
+	top := s.top.Load()
+	if top == nil {
+		return 0
+	}
+
+	return top.len
+}
+
+// String formats a snapshot of TreiberStack's values from bottom
+// to top, the same way as [StackArray.String].
+func (s *TreiberStack[T]) String() string {
+	top := s.top.Load()
+	if top == nil {
+		return fmt.Sprintf("TreiberStack{%v}", []T{})
+	}
+
+	values := make([]T, top.len)
+	for n := top; n != nil; n = n.next {
+		values[n.len-1] = n.value
+	}
+
+	return fmt.Sprintf("TreiberStack{%v}", values)
+}
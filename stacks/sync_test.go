@@ -0,0 +1,117 @@
+package stacks
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func TestSyncStack(t *testing.T) {
+	testStack(t, func() Stack[int] { return NewSyncStack[int](&StackArray[int]{}) })
+	t.Run("stress", func(t *testing.T) {
+		stress(t, NewSyncStack[int](&StackLinked[int]{}))
+	})
+
+	t.Run("String", func(t *testing.T) {
+		s := NewSyncStack[int](&StackArray[int]{})
+		fill(s, 1, 2)
+
+		if got, want := s.String(), "SyncStack{StackArray{[1 2]}}"; got != want {
+			t.Errorf("String() = %q, want %q", got, want)
+		}
+	})
+}
+
+func TestTreiberStack(t *testing.T) {
+	testStack(t, func() Stack[int] { return &TreiberStack[int]{} })
+	t.Run("stress", func(t *testing.T) {
+		stress(t, &TreiberStack[int]{})
+	})
+
+	t.Run("String", func(t *testing.T) {
+		s := &TreiberStack[int]{}
+		if got, want := s.String(), "TreiberStack{[]}"; got != want {
+			t.Errorf("String() = %q, want %q", got, want)
+		}
+
+		fill(s, 1, 2)
+		if got, want := s.String(), "TreiberStack{[1 2]}"; got != want {
+			t.Errorf("String() = %q, want %q", got, want)
+		}
+	})
+}
+
+// stress pushes and pops from many goroutines at once and checks
+// every pushed value is popped exactly once.
+func stress(t *testing.T, s Stack[int]) {
+	const (
+		goroutines = 8
+		perRoutine = 2000
+	)
+
+	var wg sync.WaitGroup
+	popped := make([][]int, goroutines)
+
+	for g := range goroutines {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for i := range perRoutine {
+				s.Push(g*perRoutine + i)
+				s.Peek()
+
+				if i%2 == 1 {
+					if v, ok := s.Pop(); ok {
+						popped[g] = append(popped[g], v)
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	seen := make([]bool, goroutines*perRoutine)
+	count := 0
+	for _, values := range append(popped, drain(s)) {
+		for _, v := range values {
+			if seen[v] {
+				t.Fatalf("value %d popped twice", v)
+			}
+			seen[v] = true
+			count++
+		}
+	}
+
+	if count != len(seen) {
+		t.Errorf("popped %d values, want %d", count, len(seen))
+	}
+	if s.Len() != 0 {
+		t.Errorf("Len() = %d, want 0", s.Len())
+	}
+}
+
+func BenchmarkConcurrentStacks(b *testing.B) {
+	stacks := []struct {
+		name     string
+		newStack func() Stack[int]
+	}{
+		{"SyncStack(StackArray)", func() Stack[int] { return NewSyncStack[int](&StackArray[int]{}) }},
+		{"SyncStack(StackLinked)", func() Stack[int] { return NewSyncStack[int](&StackLinked[int]{}) }},
+		{"TreiberStack", func() Stack[int] { return &TreiberStack[int]{} }},
+	}
+
+	for _, stack := range stacks {
+		b.Run(fmt.Sprintf("%s push-pop", stack.name), func(b *testing.B) {
+			s := stack.newStack()
+
+			b.ReportAllocs()
+			b.RunParallel(func(pb *testing.PB) {
+				for i := 0; pb.Next(); i++ {
+					s.Push(i)
+					s.Pop()
+				}
+			})
+		})
+	}
+}